@@ -30,42 +30,52 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("agent.proto", fileDescriptor_56ede974c0020f77) }
 
 var fileDescriptor_56ede974c0020f77 = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x97, 0x89, 0x15, 0xd5, 0x4d, 0x37, 0xe4, 0x6d, 0xdd, 0x88, 0x50, 0x27, 0x45, 0x20,
-	0xa1, 0x1d, 0x6c, 0x04, 0x37, 0x84, 0x04, 0xb4, 0x1d, 0x53, 0x0e, 0xa5, 0x68, 0x15, 0x15, 0xe2,
-	0xe6, 0x26, 0x56, 0x16, 0x94, 0xc4, 0x99, 0xed, 0x46, 0xab, 0x10, 0x17, 0x5e, 0x81, 0x17, 0xd9,
-	0x63, 0x70, 0x44, 0xe2, 0x8c, 0x54, 0x55, 0x3c, 0x08, 0x8a, 0x9d, 0x54, 0xdd, 0x9a, 0x82, 0xc8,
-	0x8e, 0xfe, 0xfc, 0xfd, 0x7f, 0xf6, 0xcf, 0x92, 0x3f, 0xd0, 0x20, 0x3e, 0x8d, 0x25, 0x4a, 0x38,
-	0x93, 0x0c, 0x6e, 0xa9, 0x85, 0xd5, 0x4c, 0xc8, 0x34, 0x64, 0xc4, 0xd3, 0x55, 0xeb, 0x81, 0xcf,
-	0x98, 0x1f, 0x52, 0x4c, 0x92, 0x00, 0x93, 0x38, 0x66, 0x92, 0xc8, 0x80, 0xc5, 0x22, 0xdf, 0x35,
-	0x93, 0x31, 0xf6, 0x2f, 0x42, 0xbd, 0x7a, 0xfa, 0x0b, 0x80, 0xad, 0xd7, 0x19, 0x04, 0xbe, 0x01,
-	0xb5, 0x93, 0xcb, 0x40, 0x48, 0x01, 0x21, 0x2a, 0x78, 0x83, 0xf1, 0x27, 0xea, 0x4a, 0xe4, 0xf4,
-	0xac, 0x92, 0x9a, 0xbd, 0xf7, 0xf5, 0xe7, 0xef, 0x6f, 0x9b, 0xdb, 0xd0, 0xc4, 0x54, 0x05, 0xf1,
-	0xe7, 0xc0, 0xfb, 0x02, 0x07, 0xa0, 0x36, 0xa4, 0x84, 0xbb, 0xe7, 0xf0, 0x60, 0x91, 0xd1, 0x05,
-	0x74, 0x46, 0x2f, 0x26, 0x54, 0x48, 0xeb, 0x70, 0x75, 0x43, 0x24, 0x2c, 0x16, 0xd4, 0x86, 0x0a,
-	0x69, 0xda, 0x77, 0xb1, 0x50, 0x3b, 0xcf, 0x8d, 0x63, 0xf8, 0x01, 0x00, 0xdd, 0xd6, 0x99, 0x3a,
-	0x3d, 0x78, 0xff, 0x66, 0xd6, 0xe9, 0xfd, 0x1b, 0xbb, 0xaf, 0xb0, 0x3b, 0x36, 0xc8, 0xb1, 0x38,
-	0xf0, 0x32, 0xf2, 0x29, 0x30, 0x87, 0x92, 0x53, 0x12, 0x55, 0xbf, 0xf0, 0xc6, 0x63, 0xe3, 0x89,
-	0x01, 0xfb, 0xe0, 0xde, 0x32, 0xa8, 0xfa, 0x45, 0x35, 0x6e, 0x00, 0x6a, 0x4e, 0x2c, 0x28, 0x97,
-	0xb0, 0x75, 0xf3, 0xd9, 0x47, 0xd4, 0x95, 0x8c, 0x5b, 0xfb, 0x8b, 0x7a, 0x97, 0x45, 0x11, 0x8b,
-	0xd1, 0x09, 0xe7, 0x8c, 0xdb, 0xad, 0xab, 0xd9, 0x91, 0xb1, 0x78, 0xc2, 0x40, 0x31, 0x32, 0xd1,
-	0x6e, 0x21, 0x5a, 0x0d, 0xab, 0x6f, 0xf5, 0x0a, 0x34, 0xfa, 0x93, 0x50, 0x06, 0x39, 0xe3, 0xa0,
-	0x9c, 0x21, 0xac, 0x56, 0x29, 0x44, 0xd8, 0x1b, 0x99, 0xd7, 0xfb, 0xc4, 0x23, 0x92, 0xde, 0xce,
-	0x6b, 0xa2, 0x18, 0xd7, 0xbc, 0xaa, 0x61, 0xaf, 0x7b, 0xe5, 0x8c, 0x0a, 0x5e, 0x7d, 0x50, 0x3b,
-	0xa3, 0x11, 0x4b, 0x69, 0xe9, 0xd7, 0x59, 0x73, 0xf8, 0xe1, 0xc2, 0x69, 0xfb, 0xd8, 0xc4, 0x5c,
-	0xe5, 0xf5, 0x0f, 0x7a, 0x59, 0x58, 0xfd, 0x3f, 0x54, 0x1b, 0xbd, 0xc8, 0x8d, 0xf2, 0xfc, 0xee,
-	0x6a, 0xfe, 0xef, 0x36, 0xf5, 0x53, 0x2a, 0x75, 0x6b, 0xe9, 0xd9, 0x6b, 0x5e, 0x79, 0x69, 0x1e,
-	0x30, 0x55, 0xd7, 0x36, 0x5d, 0xb0, 0xa3, 0x6d, 0xaa, 0x41, 0xb5, 0x11, 0x01, 0x8d, 0x2e, 0xa7,
-	0x44, 0x52, 0x27, 0xf6, 0xe8, 0x25, 0x7c, 0xb8, 0x74, 0xf9, 0x58, 0x72, 0x16, 0x86, 0x68, 0x69,
-	0xbb, 0xf8, 0x66, 0xab, 0x6f, 0x14, 0x25, 0x72, 0x5a, 0x0c, 0x03, 0xd8, 0xc4, 0x41, 0xd6, 0x8d,
-	0x5d, 0x95, 0x84, 0x6f, 0x41, 0x7d, 0x48, 0xd2, 0xfc, 0x80, 0xf2, 0xe8, 0x3a, 0xe2, 0xae, 0x22,
-	0x36, 0x61, 0x23, 0x27, 0x0a, 0x92, 0x52, 0xeb, 0xce, 0xd5, 0xec, 0x68, 0xb3, 0x33, 0xfa, 0x3e,
-	0x6f, 0x1b, 0x3f, 0xe6, 0x6d, 0x63, 0x36, 0x6f, 0x1b, 0x60, 0x8f, 0x71, 0x1f, 0xa5, 0x1e, 0x21,
-	0x02, 0xa5, 0x24, 0xf4, 0x90, 0x1a, 0xdf, 0x9d, 0xfa, 0x88, 0x84, 0x9e, 0x1a, 0xc2, 0xef, 0x8c,
-	0x8f, 0x8f, 0xfc, 0x40, 0x9e, 0x4f, 0xc6, 0xc8, 0x65, 0x11, 0x56, 0x9d, 0x38, 0xeb, 0xcc, 0x66,
-	0xb9, 0xc0, 0x3e, 0x4f, 0x5c, 0xac, 0x32, 0xe3, 0x9a, 0x1a, 0xdf, 0xcf, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xe4, 0x8d, 0x63, 0x13, 0x0f, 0x06, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xd1, 0x4e, 0xdb, 0x3c,
+	0x1c, 0xc5, 0x1b, 0xf4, 0x51, 0xbe, 0xba, 0x2d, 0x20, 0x03, 0x05, 0x22, 0x54, 0xa6, 0x68, 0x93,
+	0x10, 0x17, 0x31, 0xda, 0xee, 0xa6, 0x4d, 0xdb, 0x28, 0x0c, 0x75, 0x1a, 0x02, 0x51, 0xc1, 0xa6,
+	0x5d, 0x4c, 0x73, 0x13, 0x2f, 0xcd, 0x96, 0xc4, 0xc1, 0x76, 0x2b, 0xd0, 0xb4, 0x9b, 0xbd, 0xc2,
+	0x5e, 0x61, 0x0f, 0xc0, 0x63, 0xec, 0x72, 0xd2, 0x5e, 0x00, 0xa1, 0x3d, 0xc8, 0x14, 0x3b, 0xee,
+	0x0a, 0x4d, 0x56, 0x2d, 0x5c, 0xc6, 0xff, 0xff, 0xf9, 0xf9, 0x1c, 0xbb, 0x76, 0x0d, 0xaa, 0xd8,
+	0x23, 0x91, 0xb0, 0x63, 0x46, 0x05, 0x85, 0xd3, 0xf2, 0xc3, 0xac, 0xc7, 0xf8, 0x3c, 0xa0, 0xd8,
+	0x55, 0xa3, 0xe6, 0x9a, 0x47, 0xa9, 0x17, 0x10, 0x84, 0x63, 0x1f, 0xe1, 0x28, 0xa2, 0x02, 0x0b,
+	0x9f, 0x46, 0x3c, 0xad, 0xd6, 0xe2, 0x2e, 0xf2, 0x4e, 0x03, 0xf5, 0x75, 0xff, 0xdb, 0x1c, 0x98,
+	0x7e, 0x96, 0x40, 0xe0, 0x73, 0x50, 0xde, 0x3d, 0xf3, 0xb9, 0xe0, 0x10, 0xda, 0x9a, 0x77, 0xd0,
+	0xfd, 0x40, 0x1c, 0x61, 0xb7, 0x77, 0xcc, 0x8c, 0x31, 0x6b, 0xf1, 0xcb, 0xcf, 0x5f, 0x5f, 0xa7,
+	0x66, 0x61, 0x0d, 0x11, 0x29, 0x44, 0x9f, 0x7c, 0xf7, 0x33, 0x3c, 0x00, 0xe5, 0x0e, 0xc1, 0xcc,
+	0xe9, 0xc1, 0xe5, 0xa1, 0x46, 0x0d, 0xd8, 0x47, 0xe4, 0xb4, 0x4f, 0xb8, 0x30, 0x57, 0xc6, 0x0b,
+	0x3c, 0xa6, 0x11, 0x27, 0x16, 0x94, 0xc8, 0x9a, 0x35, 0x83, 0xb8, 0xac, 0x3c, 0x34, 0x36, 0xe1,
+	0x6b, 0x00, 0x54, 0xdb, 0xf6, 0x79, 0x7b, 0x07, 0xae, 0xde, 0xd4, 0xb6, 0x77, 0x26, 0x63, 0x97,
+	0x24, 0x76, 0xce, 0x02, 0x29, 0x16, 0xf9, 0x6e, 0x42, 0x7e, 0x07, 0xaa, 0xfb, 0xfd, 0x40, 0xf8,
+	0xa9, 0xdf, 0xb5, 0x9b, 0x7a, 0x59, 0xd4, 0xf4, 0xd5, 0x3c, 0x3a, 0xb7, 0x56, 0x24, 0x1e, 0x5a,
+	0x75, 0x8d, 0x0f, 0x13, 0x61, 0x32, 0xc3, 0x1e, 0xa8, 0x75, 0x04, 0x23, 0x38, 0x2c, 0xbe, 0x24,
+	0xa5, 0x0d, 0x63, 0xcb, 0x80, 0xfb, 0x60, 0x7e, 0x14, 0x54, 0x7c, 0x29, 0x14, 0xee, 0x00, 0x94,
+	0xdb, 0x11, 0x27, 0x4c, 0xc0, 0xc6, 0xcd, 0x8d, 0x3d, 0x21, 0x8e, 0xa0, 0xcc, 0x5c, 0x1a, 0x8e,
+	0xb7, 0x68, 0x18, 0xd2, 0xc8, 0xde, 0x65, 0x8c, 0x32, 0xab, 0x71, 0x71, 0xb9, 0x6e, 0x0c, 0x37,
+	0xc9, 0x97, 0x8c, 0x24, 0x68, 0x4b, 0x07, 0x2d, 0x86, 0x55, 0xae, 0x9e, 0xa6, 0xfb, 0x91, 0x32,
+	0x96, 0xb3, 0x19, 0xdc, 0x6c, 0x64, 0x42, 0xb8, 0x55, 0x4a, 0x72, 0x1d, 0xc7, 0x2e, 0x16, 0xe4,
+	0x76, 0xb9, 0xfa, 0x92, 0x71, 0x2d, 0x57, 0x31, 0xec, 0xf5, 0x5c, 0x29, 0xa3, 0x40, 0xae, 0x7d,
+	0x50, 0x3e, 0x22, 0x21, 0x1d, 0x90, 0xcc, 0xc3, 0x99, 0x33, 0xf9, 0xca, 0x30, 0xd3, 0xec, 0x66,
+	0x0d, 0x31, 0xa9, 0x57, 0x67, 0xf4, 0x89, 0x4e, 0xf5, 0xef, 0x50, 0x95, 0xe8, 0x51, 0x9a, 0x28,
+	0xd5, 0x2f, 0x8c, 0xeb, 0xff, 0x9e, 0xa6, 0xb2, 0x47, 0x84, 0x6a, 0xcd, 0x9c, 0x3b, 0x67, 0x95,
+	0x47, 0x6e, 0x1c, 0x2a, 0xc7, 0x55, 0x9a, 0x16, 0x98, 0x53, 0x69, 0x8a, 0x41, 0x55, 0xa2, 0x17,
+	0xfa, 0x80, 0xbd, 0xf4, 0xb9, 0xa6, 0x34, 0x47, 0x12, 0x44, 0x82, 0xd1, 0x20, 0xb0, 0xdb, 0x91,
+	0x4b, 0xce, 0xf4, 0x29, 0xcb, 0xba, 0x14, 0x4b, 0x5b, 0x06, 0xc4, 0xa0, 0xda, 0x62, 0x04, 0x0b,
+	0x22, 0xbb, 0xe1, 0xdd, 0x71, 0xcc, 0x48, 0x59, 0xc3, 0xc6, 0xd7, 0x3b, 0x8c, 0xc5, 0xb9, 0xbe,
+	0xba, 0x60, 0x1d, 0xf9, 0x49, 0x37, 0x72, 0xa4, 0x12, 0xbe, 0x02, 0x95, 0x0e, 0x1e, 0xa4, 0x13,
+	0x4c, 0xf2, 0x99, 0x83, 0x5e, 0x90, 0xe8, 0x3a, 0xac, 0xa6, 0x68, 0x8e, 0x07, 0x04, 0xbe, 0x05,
+	0x33, 0x2d, 0x1a, 0xc6, 0xd8, 0x11, 0xb7, 0xf3, 0xdd, 0x90, 0xf0, 0x79, 0x38, 0xab, 0x7d, 0xa7,
+	0xd0, 0x63, 0x50, 0x91, 0xf2, 0x76, 0xf4, 0x9e, 0x4e, 0x34, 0xfe, 0xe7, 0x77, 0x95, 0xb4, 0xab,
+	0xda, 0x98, 0x6d, 0x3f, 0x21, 0xed, 0x81, 0xff, 0x3b, 0x11, 0x8e, 0x79, 0x8f, 0x4e, 0xde, 0xb6,
+	0x91, 0x4b, 0x38, 0x95, 0xd8, 0xad, 0x5e, 0x3f, 0xfa, 0x28, 0xf7, 0xee, 0x31, 0x98, 0x39, 0x22,
+	0x5c, 0x50, 0x46, 0x60, 0x5e, 0x5f, 0x5e, 0xe4, 0xd2, 0x86, 0xba, 0x58, 0xc3, 0x98, 0x32, 0x01,
+	0xd7, 0x33, 0x5c, 0xc8, 0x8a, 0xb6, 0x71, 0x27, 0xaf, 0xe1, 0x90, 0x51, 0x8f, 0x11, 0xce, 0x13,
+	0x3f, 0xe6, 0x7f, 0x17, 0x97, 0xeb, 0x53, 0xdb, 0x27, 0xdf, 0xaf, 0x9a, 0xc6, 0x8f, 0xab, 0xa6,
+	0x71, 0x79, 0xd5, 0x34, 0xc0, 0x22, 0x65, 0x9e, 0x3d, 0x70, 0x31, 0xe6, 0xf6, 0x00, 0x07, 0xae,
+	0x2d, 0x5f, 0x01, 0xdb, 0x95, 0x13, 0x1c, 0xb8, 0xf2, 0xbf, 0xfc, 0xd0, 0x78, 0x73, 0xcf, 0xf3,
+	0x45, 0xaf, 0xdf, 0xb5, 0x1d, 0x1a, 0x22, 0xd9, 0x89, 0x92, 0xce, 0xe4, 0x49, 0xc0, 0x91, 0xc7,
+	0x62, 0x07, 0x49, 0x4d, 0xb7, 0x2c, 0x5f, 0x01, 0x0f, 0x7e, 0x0f, 0x00, 0xb3, 0xbc, 0xd4, 0xf8,
+	0x56, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -83,6 +93,7 @@ type AgentClient interface {
 	Exists(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Object_ID, error)
 	Search(ctx context.Context, in *payload.Search_Request, opts ...grpc.CallOption) (*payload.Search_Response, error)
 	SearchByID(ctx context.Context, in *payload.Search_IDRequest, opts ...grpc.CallOption) (*payload.Search_Response, error)
+	MultiSearch(ctx context.Context, in *payload.Search_MultiRequest, opts ...grpc.CallOption) (*payload.Search_Responses, error)
 	StreamSearch(ctx context.Context, opts ...grpc.CallOption) (Agent_StreamSearchClient, error)
 	StreamSearchByID(ctx context.Context, opts ...grpc.CallOption) (Agent_StreamSearchByIDClient, error)
 	Insert(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Common_Error, error)
@@ -96,8 +107,14 @@ type AgentClient interface {
 	MultiRemove(ctx context.Context, in *payload.Object_IDs, opts ...grpc.CallOption) (*payload.Common_Errors, error)
 	GetObject(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Object_Vector, error)
 	StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (Agent_StreamGetObjectClient, error)
+	StreamListObject(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (Agent_StreamListObjectClient, error)
 	CreateIndex(ctx context.Context, in *payload.Controll_CreateIndexRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error)
-	SaveIndex(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Common_Empty, error)
+	SaveIndex(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error)
+	Compact(ctx context.Context, in *payload.Controll_CreateIndexRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error)
+	IndexInfo(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (*payload.Info_Index, error)
+	Snapshot(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (Agent_SnapshotClient, error)
+	Restore(ctx context.Context, opts ...grpc.CallOption) (Agent_RestoreClient, error)
+	Import(ctx context.Context, in *payload.Controll_ImportRequest, opts ...grpc.CallOption) (Agent_ImportClient, error)
 }
 
 type agentClient struct {
@@ -135,6 +152,15 @@ func (c *agentClient) SearchByID(ctx context.Context, in *payload.Search_IDReque
 	return out, nil
 }
 
+func (c *agentClient) MultiSearch(ctx context.Context, in *payload.Search_MultiRequest, opts ...grpc.CallOption) (*payload.Search_Responses, error) {
+	out := new(payload.Search_Responses)
+	err := c.cc.Invoke(ctx, "/agent.Agent/MultiSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) StreamSearch(ctx context.Context, opts ...grpc.CallOption) (Agent_StreamSearchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Agent_serviceDesc.Streams[0], "/agent.Agent/StreamSearch", opts...)
 	if err != nil {
@@ -384,6 +410,38 @@ func (x *agentStreamGetObjectClient) Recv() (*payload.Object_Vector, error) {
 	return m, nil
 }
 
+func (c *agentClient) StreamListObject(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (Agent_StreamListObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Agent_serviceDesc.Streams[6], "/agent.Agent/StreamListObject", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentStreamListObjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Agent_StreamListObjectClient interface {
+	Recv() (*payload.Object_ID, error)
+	grpc.ClientStream
+}
+
+type agentStreamListObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentStreamListObjectClient) Recv() (*payload.Object_ID, error) {
+	m := new(payload.Object_ID)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *agentClient) CreateIndex(ctx context.Context, in *payload.Controll_CreateIndexRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error) {
 	out := new(payload.Common_Empty)
 	err := c.cc.Invoke(ctx, "/agent.Agent/CreateIndex", in, out, opts...)
@@ -393,7 +451,7 @@ func (c *agentClient) CreateIndex(ctx context.Context, in *payload.Controll_Crea
 	return out, nil
 }
 
-func (c *agentClient) SaveIndex(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Common_Empty, error) {
+func (c *agentClient) SaveIndex(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error) {
 	out := new(payload.Common_Empty)
 	err := c.cc.Invoke(ctx, "/agent.Agent/SaveIndex", in, out, opts...)
 	if err != nil {
@@ -402,11 +460,128 @@ func (c *agentClient) SaveIndex(ctx context.Context, in *payload.Common_Empty, o
 	return out, nil
 }
 
+func (c *agentClient) Compact(ctx context.Context, in *payload.Controll_CreateIndexRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error) {
+	out := new(payload.Common_Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/Compact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) IndexInfo(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (*payload.Info_Index, error) {
+	out := new(payload.Info_Index)
+	err := c.cc.Invoke(ctx, "/agent.Agent/IndexInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) Snapshot(ctx context.Context, in *payload.Controll_IndexRequest, opts ...grpc.CallOption) (Agent_SnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Agent_serviceDesc.Streams[7], "/agent.Agent/Snapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Agent_SnapshotClient interface {
+	Recv() (*payload.Snapshot_Chunk, error)
+	grpc.ClientStream
+}
+
+type agentSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentSnapshotClient) Recv() (*payload.Snapshot_Chunk, error) {
+	m := new(payload.Snapshot_Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) Restore(ctx context.Context, opts ...grpc.CallOption) (Agent_RestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Agent_serviceDesc.Streams[8], "/agent.Agent/Restore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentRestoreClient{stream}
+	return x, nil
+}
+
+type Agent_RestoreClient interface {
+	Send(*payload.Snapshot_Chunk) error
+	CloseAndRecv() (*payload.Common_Empty, error)
+	grpc.ClientStream
+}
+
+type agentRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentRestoreClient) Send(m *payload.Snapshot_Chunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentRestoreClient) CloseAndRecv() (*payload.Common_Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(payload.Common_Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) Import(ctx context.Context, in *payload.Controll_ImportRequest, opts ...grpc.CallOption) (Agent_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Agent_serviceDesc.Streams[9], "/agent.Agent/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentImportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Agent_ImportClient interface {
+	Recv() (*payload.Controll_ImportProgress, error)
+	grpc.ClientStream
+}
+
+type agentImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentImportClient) Recv() (*payload.Controll_ImportProgress, error) {
+	m := new(payload.Controll_ImportProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AgentServer is the server API for Agent service.
 type AgentServer interface {
 	Exists(context.Context, *payload.Object_ID) (*payload.Object_ID, error)
 	Search(context.Context, *payload.Search_Request) (*payload.Search_Response, error)
 	SearchByID(context.Context, *payload.Search_IDRequest) (*payload.Search_Response, error)
+	MultiSearch(context.Context, *payload.Search_MultiRequest) (*payload.Search_Responses, error)
 	StreamSearch(Agent_StreamSearchServer) error
 	StreamSearchByID(Agent_StreamSearchByIDServer) error
 	Insert(context.Context, *payload.Object_Vector) (*payload.Common_Error, error)
@@ -420,8 +595,14 @@ type AgentServer interface {
 	MultiRemove(context.Context, *payload.Object_IDs) (*payload.Common_Errors, error)
 	GetObject(context.Context, *payload.Object_ID) (*payload.Object_Vector, error)
 	StreamGetObject(Agent_StreamGetObjectServer) error
+	StreamListObject(*payload.Controll_IndexRequest, Agent_StreamListObjectServer) error
 	CreateIndex(context.Context, *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error)
-	SaveIndex(context.Context, *payload.Common_Empty) (*payload.Common_Empty, error)
+	SaveIndex(context.Context, *payload.Controll_IndexRequest) (*payload.Common_Empty, error)
+	Compact(context.Context, *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error)
+	IndexInfo(context.Context, *payload.Controll_IndexRequest) (*payload.Info_Index, error)
+	Snapshot(*payload.Controll_IndexRequest, Agent_SnapshotServer) error
+	Restore(Agent_RestoreServer) error
+	Import(*payload.Controll_ImportRequest, Agent_ImportServer) error
 }
 
 // UnimplementedAgentServer can be embedded to have forward compatible implementations.
@@ -437,6 +618,9 @@ func (*UnimplementedAgentServer) Search(ctx context.Context, req *payload.Search
 func (*UnimplementedAgentServer) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchByID not implemented")
 }
+func (*UnimplementedAgentServer) MultiSearch(ctx context.Context, req *payload.Search_MultiRequest) (*payload.Search_Responses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSearch not implemented")
+}
 func (*UnimplementedAgentServer) StreamSearch(srv Agent_StreamSearchServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamSearch not implemented")
 }
@@ -476,12 +660,30 @@ func (*UnimplementedAgentServer) GetObject(ctx context.Context, req *payload.Obj
 func (*UnimplementedAgentServer) StreamGetObject(srv Agent_StreamGetObjectServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamGetObject not implemented")
 }
+func (*UnimplementedAgentServer) StreamListObject(req *payload.Controll_IndexRequest, srv Agent_StreamListObjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamListObject not implemented")
+}
 func (*UnimplementedAgentServer) CreateIndex(ctx context.Context, req *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
-func (*UnimplementedAgentServer) SaveIndex(ctx context.Context, req *payload.Common_Empty) (*payload.Common_Empty, error) {
+func (*UnimplementedAgentServer) SaveIndex(ctx context.Context, req *payload.Controll_IndexRequest) (*payload.Common_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveIndex not implemented")
 }
+func (*UnimplementedAgentServer) Compact(ctx context.Context, req *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compact not implemented")
+}
+func (*UnimplementedAgentServer) IndexInfo(ctx context.Context, req *payload.Controll_IndexRequest) (*payload.Info_Index, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexInfo not implemented")
+}
+func (*UnimplementedAgentServer) Snapshot(req *payload.Controll_IndexRequest, srv Agent_SnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (*UnimplementedAgentServer) Restore(srv Agent_RestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedAgentServer) Import(req *payload.Controll_ImportRequest, srv Agent_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterAgentServer(s *grpc.Server, srv AgentServer) {
 	s.RegisterService(&_Agent_serviceDesc, srv)
@@ -541,6 +743,24 @@ func _Agent_SearchByID_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_MultiSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Search_MultiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).MultiSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/MultiSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).MultiSearch(ctx, req.(*payload.Search_MultiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_StreamSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(AgentServer).StreamSearch(&agentStreamSearchServer{stream})
 }
@@ -823,6 +1043,27 @@ func (x *agentStreamGetObjectServer) Recv() (*payload.Object_ID, error) {
 	return m, nil
 }
 
+func _Agent_StreamListObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(payload.Controll_IndexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).StreamListObject(m, &agentStreamListObjectServer{stream})
+}
+
+type Agent_StreamListObjectServer interface {
+	Send(*payload.Object_ID) error
+	grpc.ServerStream
+}
+
+type agentStreamListObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentStreamListObjectServer) Send(m *payload.Object_ID) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Agent_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.Controll_CreateIndexRequest)
 	if err := dec(in); err != nil {
@@ -842,7 +1083,7 @@ func _Agent_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(i
 }
 
 func _Agent_SaveIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(payload.Common_Empty)
+	in := new(payload.Controll_IndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -854,11 +1095,115 @@ func _Agent_SaveIndex_Handler(srv interface{}, ctx context.Context, dec func(int
 		FullMethod: "/agent.Agent/SaveIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServer).SaveIndex(ctx, req.(*payload.Common_Empty))
+		return srv.(AgentServer).SaveIndex(ctx, req.(*payload.Controll_IndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Controll_CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Compact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/Compact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Compact(ctx, req.(*payload.Controll_CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_IndexInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Controll_IndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).IndexInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/IndexInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).IndexInfo(ctx, req.(*payload.Controll_IndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_Snapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(payload.Controll_IndexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).Snapshot(m, &agentSnapshotServer{stream})
+}
+
+type Agent_SnapshotServer interface {
+	Send(*payload.Snapshot_Chunk) error
+	grpc.ServerStream
+}
+
+type agentSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentSnapshotServer) Send(m *payload.Snapshot_Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Agent_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).Restore(&agentRestoreServer{stream})
+}
+
+type Agent_RestoreServer interface {
+	SendAndClose(*payload.Common_Empty) error
+	Recv() (*payload.Snapshot_Chunk, error)
+	grpc.ServerStream
+}
+
+type agentRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentRestoreServer) SendAndClose(m *payload.Common_Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentRestoreServer) Recv() (*payload.Snapshot_Chunk, error) {
+	m := new(payload.Snapshot_Chunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(payload.Controll_ImportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).Import(m, &agentImportServer{stream})
+}
+
+type Agent_ImportServer interface {
+	Send(*payload.Controll_ImportProgress) error
+	grpc.ServerStream
+}
+
+type agentImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentImportServer) Send(m *payload.Controll_ImportProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Agent_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "agent.Agent",
 	HandlerType: (*AgentServer)(nil),
@@ -875,6 +1220,10 @@ var _Agent_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchByID",
 			Handler:    _Agent_SearchByID_Handler,
 		},
+		{
+			MethodName: "MultiSearch",
+			Handler:    _Agent_MultiSearch_Handler,
+		},
 		{
 			MethodName: "Insert",
 			Handler:    _Agent_Insert_Handler,
@@ -911,6 +1260,14 @@ var _Agent_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SaveIndex",
 			Handler:    _Agent_SaveIndex_Handler,
 		},
+		{
+			MethodName: "Compact",
+			Handler:    _Agent_Compact_Handler,
+		},
+		{
+			MethodName: "IndexInfo",
+			Handler:    _Agent_IndexInfo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -949,6 +1306,26 @@ var _Agent_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamListObject",
+			Handler:       _Agent_StreamListObject_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Snapshot",
+			Handler:       _Agent_Snapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _Agent_Restore_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Agent_Import_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "agent.proto",
 }
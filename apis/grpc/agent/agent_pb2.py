@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='agent',
   syntax='proto3',
   serialized_options=_b('\n\024org.vdaas.vald.agentB\tValdAgentP\001Z%github.com/vdaas/vald/apis/grpc/agent'),
-  serialized_pb=_b('\n\x0b\x61gent.proto\x12\x05\x61gent\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\xdd\n\n\x05\x41gent\x12\x46\n\x06\x45xists\x12\x12.payload.Object.ID\x1a\x12.payload.Object.ID\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/exists/{id}\x12O\n\x06Search\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x12\x82\xd3\xe4\x93\x02\x0c\"\x07/search:\x01*\x12X\n\nSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x15\x82\xd3\xe4\x93\x02\x0f\"\n/search/id:\x01*\x12G\n\x0cStreamSearch\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12M\n\x10StreamSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12O\n\x06Insert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/insert:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamInsert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiInsert\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12O\n\x06Update\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/update:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamUpdate\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiUpdate\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12M\n\x06Remove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x18\x82\xd3\xe4\x93\x02\x0e*\x0c/remove/{id}\xb0\xe0\x1f\x01\x12?\n\x0cStreamRemove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12<\n\x0bMultiRemove\x12\x13.payload.Object.IDs\x1a\x16.payload.Common.Errors\"\x00\x12M\n\tGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/object/{id}\x12\x43\n\x0fStreamGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x00(\x01\x30\x01\x12\x61\n\x0b\x43reateIndex\x12$.payload.Controll.CreateIndexRequest\x1a\x15.payload.Common.Empty\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/index/create\x12N\n\tSaveIndex\x12\x15.payload.Common.Empty\x1a\x15.payload.Common.Empty\"\x13\x82\xd3\xe4\x93\x02\r\x12\x0b/index/save\x1a\x04\xb0\xe0\x1f\x02\x42J\n\x14org.vdaas.vald.agentB\tValdAgentP\x01Z%github.com/vdaas/vald/apis/grpc/agentb\x06proto3')
+  serialized_pb=_b('\n\x0b\x61gent.proto\x12\x05\x61gent\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\xa4\x0f\n\x05\x41gent\x12\x46\n\x06\x45xists\x12\x12.payload.Object.ID\x1a\x12.payload.Object.ID\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/exists/{id}\x12O\n\x06Search\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x12\x82\xd3\xe4\x93\x02\x0c\"\x07/search:\x01*\x12X\n\nSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x15\x82\xd3\xe4\x93\x02\x0f\"\n/search/id:\x01*\x12`\n\x0bMultiSearch\x12\x1c.payload.Search.MultiRequest\x1a\x19.payload.Search.Responses\"\x18\x82\xd3\xe4\x93\x02\x12\"\r/search/multi:\x01*\x12G\n\x0cStreamSearch\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12M\n\x10StreamSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12O\n\x06Insert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/insert:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamInsert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiInsert\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12O\n\x06Update\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/update:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamUpdate\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiUpdate\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12M\n\x06Remove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x18\x82\xd3\xe4\x93\x02\x0e*\x0c/remove/{id}\xb0\xe0\x1f\x01\x12?\n\x0cStreamRemove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12<\n\x0bMultiRemove\x12\x13.payload.Object.IDs\x1a\x16.payload.Common.Errors\"\x00\x12M\n\tGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/object/{id}\x12\x43\n\x0fStreamGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x00(\x01\x30\x01\x12J\n\x10StreamListObject\x12\x1e.payload.Controll.IndexRequest\x1a\x12.payload.Object.ID\"\x00\x30\x01\x12\x61\n\x0b\x43reateIndex\x12$.payload.Controll.CreateIndexRequest\x1a\x15.payload.Common.Empty\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/index/create\x12W\n\tSaveIndex\x12\x1e.payload.Controll.IndexRequest\x1a\x15.payload.Common.Empty\"\x13\x82\xd3\xe4\x93\x02\r\x12\x0b/index/save\x12^\n\x07\x43ompact\x12$.payload.Controll.CreateIndexRequest\x1a\x15.payload.Common.Empty\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/index/compact\x12U\n\tIndexInfo\x12\x1e.payload.Controll.IndexRequest\x1a\x13.payload.Info.Index\"\x13\x82\xd3\xe4\x93\x02\r\x12\x0b/index/info\x12G\n\x08Snapshot\x12\x1e.payload.Controll.IndexRequest\x1a\x17.payload.Snapshot.Chunk\"\x00\x30\x01\x12=\n\x07Restore\x12\x17.payload.Snapshot.Chunk\x1a\x15.payload.Common.Empty\"\x00(\x01\x12O\n\x06Import\x12\x1f.payload.Controll.ImportRequest\x1a .payload.Controll.ImportProgress\"\x00\x30\x01\x1a\x04\xb0\xe0\x1f\x02\x42J\n\x14org.vdaas.vald.agentB\tValdAgentP\x01Z%github.com/vdaas/vald/apis/grpc/agentb\x06proto3')
   ,
   dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
@@ -41,7 +41,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=_b('\260\340\037\002'),
   serialized_start=82,
-  serialized_end=1455,
+  serialized_end=2038,
   methods=[
   _descriptor.MethodDescriptor(
     name='Exists',
@@ -70,10 +70,19 @@ _AGENT = _descriptor.ServiceDescriptor(
     output_type=payload__pb2._SEARCH_RESPONSE,
     serialized_options=_b('\202\323\344\223\002\017\"\n/search/id:\001*'),
   ),
+  _descriptor.MethodDescriptor(
+    name='MultiSearch',
+    full_name='agent.Agent.MultiSearch',
+    index=3,
+    containing_service=None,
+    input_type=payload__pb2._SEARCH_MULTIREQUEST,
+    output_type=payload__pb2._SEARCH_RESPONSES,
+    serialized_options=_b('\202\323\344\223\002\022\"\r/search/multi:\001*'),
+  ),
   _descriptor.MethodDescriptor(
     name='StreamSearch',
     full_name='agent.Agent.StreamSearch',
-    index=3,
+    index=4,
     containing_service=None,
     input_type=payload__pb2._SEARCH_REQUEST,
     output_type=payload__pb2._SEARCH_RESPONSE,
@@ -82,7 +91,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamSearchByID',
     full_name='agent.Agent.StreamSearchByID',
-    index=4,
+    index=5,
     containing_service=None,
     input_type=payload__pb2._SEARCH_IDREQUEST,
     output_type=payload__pb2._SEARCH_RESPONSE,
@@ -91,7 +100,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Insert',
     full_name='agent.Agent.Insert',
-    index=5,
+    index=6,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -100,7 +109,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamInsert',
     full_name='agent.Agent.StreamInsert',
-    index=6,
+    index=7,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -109,7 +118,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='MultiInsert',
     full_name='agent.Agent.MultiInsert',
-    index=7,
+    index=8,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTORS,
     output_type=payload__pb2._COMMON_ERRORS,
@@ -118,7 +127,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Update',
     full_name='agent.Agent.Update',
-    index=8,
+    index=9,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -127,7 +136,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamUpdate',
     full_name='agent.Agent.StreamUpdate',
-    index=9,
+    index=10,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -136,7 +145,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='MultiUpdate',
     full_name='agent.Agent.MultiUpdate',
-    index=10,
+    index=11,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTORS,
     output_type=payload__pb2._COMMON_ERRORS,
@@ -145,7 +154,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Remove',
     full_name='agent.Agent.Remove',
-    index=11,
+    index=12,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._COMMON_ERROR,
@@ -154,7 +163,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamRemove',
     full_name='agent.Agent.StreamRemove',
-    index=12,
+    index=13,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._COMMON_ERROR,
@@ -163,7 +172,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='MultiRemove',
     full_name='agent.Agent.MultiRemove',
-    index=13,
+    index=14,
     containing_service=None,
     input_type=payload__pb2._OBJECT_IDS,
     output_type=payload__pb2._COMMON_ERRORS,
@@ -172,7 +181,7 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetObject',
     full_name='agent.Agent.GetObject',
-    index=14,
+    index=15,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._OBJECT_VECTOR,
@@ -181,16 +190,25 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamGetObject',
     full_name='agent.Agent.StreamGetObject',
-    index=15,
+    index=16,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._OBJECT_VECTOR,
     serialized_options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='StreamListObject',
+    full_name='agent.Agent.StreamListObject',
+    index=17,
+    containing_service=None,
+    input_type=payload__pb2._CONTROLL_INDEXREQUEST,
+    output_type=payload__pb2._OBJECT_ID,
+    serialized_options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='CreateIndex',
     full_name='agent.Agent.CreateIndex',
-    index=16,
+    index=18,
     containing_service=None,
     input_type=payload__pb2._CONTROLL_CREATEINDEXREQUEST,
     output_type=payload__pb2._COMMON_EMPTY,
@@ -199,12 +217,57 @@ _AGENT = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='SaveIndex',
     full_name='agent.Agent.SaveIndex',
-    index=17,
+    index=19,
     containing_service=None,
-    input_type=payload__pb2._COMMON_EMPTY,
+    input_type=payload__pb2._CONTROLL_INDEXREQUEST,
     output_type=payload__pb2._COMMON_EMPTY,
     serialized_options=_b('\202\323\344\223\002\r\022\013/index/save'),
   ),
+  _descriptor.MethodDescriptor(
+    name='Compact',
+    full_name='agent.Agent.Compact',
+    index=20,
+    containing_service=None,
+    input_type=payload__pb2._CONTROLL_CREATEINDEXREQUEST,
+    output_type=payload__pb2._COMMON_EMPTY,
+    serialized_options=_b('\202\323\344\223\002\020\022\016/index/compact'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='IndexInfo',
+    full_name='agent.Agent.IndexInfo',
+    index=21,
+    containing_service=None,
+    input_type=payload__pb2._CONTROLL_INDEXREQUEST,
+    output_type=payload__pb2._INFO_INDEX,
+    serialized_options=_b('\202\323\344\223\002\r\022\013/index/info'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Snapshot',
+    full_name='agent.Agent.Snapshot',
+    index=22,
+    containing_service=None,
+    input_type=payload__pb2._CONTROLL_INDEXREQUEST,
+    output_type=payload__pb2._SNAPSHOT_CHUNK,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Restore',
+    full_name='agent.Agent.Restore',
+    index=23,
+    containing_service=None,
+    input_type=payload__pb2._SNAPSHOT_CHUNK,
+    output_type=payload__pb2._COMMON_EMPTY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Import',
+    full_name='agent.Agent.Import',
+    index=24,
+    containing_service=None,
+    input_type=payload__pb2._CONTROLL_IMPORTREQUEST,
+    output_type=payload__pb2._CONTROLL_IMPORTPROGRESS,
+    serialized_options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_AGENT)
 
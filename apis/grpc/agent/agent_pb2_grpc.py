@@ -29,6 +29,11 @@ class AgentStub(object):
         request_serializer=payload__pb2.Search.IDRequest.SerializeToString,
         response_deserializer=payload__pb2.Search.Response.FromString,
         )
+    self.MultiSearch = channel.unary_unary(
+        '/agent.Agent/MultiSearch',
+        request_serializer=payload__pb2.Search.MultiRequest.SerializeToString,
+        response_deserializer=payload__pb2.Search.Responses.FromString,
+        )
     self.StreamSearch = channel.stream_stream(
         '/agent.Agent/StreamSearch',
         request_serializer=payload__pb2.Search.Request.SerializeToString,
@@ -94,6 +99,11 @@ class AgentStub(object):
         request_serializer=payload__pb2.Object.ID.SerializeToString,
         response_deserializer=payload__pb2.Object.Vector.FromString,
         )
+    self.StreamListObject = channel.unary_stream(
+        '/agent.Agent/StreamListObject',
+        request_serializer=payload__pb2.Controll.IndexRequest.SerializeToString,
+        response_deserializer=payload__pb2.Object.ID.FromString,
+        )
     self.CreateIndex = channel.unary_unary(
         '/agent.Agent/CreateIndex',
         request_serializer=payload__pb2.Controll.CreateIndexRequest.SerializeToString,
@@ -101,9 +111,34 @@ class AgentStub(object):
         )
     self.SaveIndex = channel.unary_unary(
         '/agent.Agent/SaveIndex',
-        request_serializer=payload__pb2.Common.Empty.SerializeToString,
+        request_serializer=payload__pb2.Controll.IndexRequest.SerializeToString,
+        response_deserializer=payload__pb2.Common.Empty.FromString,
+        )
+    self.Compact = channel.unary_unary(
+        '/agent.Agent/Compact',
+        request_serializer=payload__pb2.Controll.CreateIndexRequest.SerializeToString,
+        response_deserializer=payload__pb2.Common.Empty.FromString,
+        )
+    self.IndexInfo = channel.unary_unary(
+        '/agent.Agent/IndexInfo',
+        request_serializer=payload__pb2.Controll.IndexRequest.SerializeToString,
+        response_deserializer=payload__pb2.Info.Index.FromString,
+        )
+    self.Snapshot = channel.unary_stream(
+        '/agent.Agent/Snapshot',
+        request_serializer=payload__pb2.Controll.IndexRequest.SerializeToString,
+        response_deserializer=payload__pb2.Snapshot.Chunk.FromString,
+        )
+    self.Restore = channel.stream_unary(
+        '/agent.Agent/Restore',
+        request_serializer=payload__pb2.Snapshot.Chunk.SerializeToString,
         response_deserializer=payload__pb2.Common.Empty.FromString,
         )
+    self.Import = channel.unary_stream(
+        '/agent.Agent/Import',
+        request_serializer=payload__pb2.Controll.ImportRequest.SerializeToString,
+        response_deserializer=payload__pb2.Controll.ImportProgress.FromString,
+        )
 
 
 class AgentServicer(object):
@@ -131,6 +166,13 @@ class AgentServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def MultiSearch(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def StreamSearch(self, request_iterator, context):
     # missing associated documentation comment in .proto file
     pass
@@ -222,6 +264,13 @@ class AgentServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def StreamListObject(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def CreateIndex(self, request, context):
     # missing associated documentation comment in .proto file
     pass
@@ -236,6 +285,41 @@ class AgentServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Compact(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def IndexInfo(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Snapshot(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Restore(self, request_iterator, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Import(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_AgentServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -254,6 +338,11 @@ def add_AgentServicer_to_server(servicer, server):
           request_deserializer=payload__pb2.Search.IDRequest.FromString,
           response_serializer=payload__pb2.Search.Response.SerializeToString,
       ),
+      'MultiSearch': grpc.unary_unary_rpc_method_handler(
+          servicer.MultiSearch,
+          request_deserializer=payload__pb2.Search.MultiRequest.FromString,
+          response_serializer=payload__pb2.Search.Responses.SerializeToString,
+      ),
       'StreamSearch': grpc.stream_stream_rpc_method_handler(
           servicer.StreamSearch,
           request_deserializer=payload__pb2.Search.Request.FromString,
@@ -319,6 +408,11 @@ def add_AgentServicer_to_server(servicer, server):
           request_deserializer=payload__pb2.Object.ID.FromString,
           response_serializer=payload__pb2.Object.Vector.SerializeToString,
       ),
+      'StreamListObject': grpc.unary_stream_rpc_method_handler(
+          servicer.StreamListObject,
+          request_deserializer=payload__pb2.Controll.IndexRequest.FromString,
+          response_serializer=payload__pb2.Object.ID.SerializeToString,
+      ),
       'CreateIndex': grpc.unary_unary_rpc_method_handler(
           servicer.CreateIndex,
           request_deserializer=payload__pb2.Controll.CreateIndexRequest.FromString,
@@ -326,9 +420,34 @@ def add_AgentServicer_to_server(servicer, server):
       ),
       'SaveIndex': grpc.unary_unary_rpc_method_handler(
           servicer.SaveIndex,
-          request_deserializer=payload__pb2.Common.Empty.FromString,
+          request_deserializer=payload__pb2.Controll.IndexRequest.FromString,
           response_serializer=payload__pb2.Common.Empty.SerializeToString,
       ),
+      'Compact': grpc.unary_unary_rpc_method_handler(
+          servicer.Compact,
+          request_deserializer=payload__pb2.Controll.CreateIndexRequest.FromString,
+          response_serializer=payload__pb2.Common.Empty.SerializeToString,
+      ),
+      'IndexInfo': grpc.unary_unary_rpc_method_handler(
+          servicer.IndexInfo,
+          request_deserializer=payload__pb2.Controll.IndexRequest.FromString,
+          response_serializer=payload__pb2.Info.Index.SerializeToString,
+      ),
+      'Snapshot': grpc.unary_stream_rpc_method_handler(
+          servicer.Snapshot,
+          request_deserializer=payload__pb2.Controll.IndexRequest.FromString,
+          response_serializer=payload__pb2.Snapshot.Chunk.SerializeToString,
+      ),
+      'Restore': grpc.stream_unary_rpc_method_handler(
+          servicer.Restore,
+          request_deserializer=payload__pb2.Snapshot.Chunk.FromString,
+          response_serializer=payload__pb2.Common.Empty.SerializeToString,
+      ),
+      'Import': grpc.unary_stream_rpc_method_handler(
+          servicer.Import,
+          request_deserializer=payload__pb2.Controll.ImportRequest.FromString,
+          response_serializer=payload__pb2.Controll.ImportProgress.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'agent.Agent', rpc_method_handlers)
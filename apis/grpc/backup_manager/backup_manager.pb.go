@@ -4,10 +4,15 @@
 package backup_manager
 
 import (
+	context "context"
 	fmt "fmt"
 	_ "github.com/danielvladco/go-proto-gql/pb"
 	proto "github.com/gogo/protobuf/proto"
+	payload "github.com/vdaas/vald/apis/grpc/payload"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -25,14 +30,177 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("backup_manager.proto", fileDescriptor_4f75347abe93af04) }
 
 var fileDescriptor_4f75347abe93af04 = []byte{
-	// 140 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x4a, 0x4c, 0xce,
-	0x2e, 0x2d, 0x88, 0xcf, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x43, 0x15, 0x95, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8,
-	0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xa8, 0x96, 0xe2,
-	0x29, 0x48, 0xd2, 0x4f, 0x2f, 0xcc, 0x81, 0xf0, 0x9c, 0x6c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0xfd, 0xb2, 0x94, 0xc4, 0xc4, 0x62, 0xfd, 0xb2, 0xc4, 0x9c, 0x14, 0x90, 0x41,
-	0xc5, 0xfa, 0xe9, 0x45, 0x05, 0xc9, 0xfa, 0xa8, 0x36, 0x25, 0xb1, 0x81, 0x0d, 0x31, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xf9, 0x5c, 0x93, 0x95, 0x98, 0x00, 0x00, 0x00,
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0xc9, 0x78, 0xd9, 0x5e, 0xea, 0x26, 0x58, 0xd4, 0x41, 0xd1, 0x0e, 0x7a, 0xdc, 0x21,
+	0x01, 0xbd, 0xed, 0x38, 0xf1, 0x20, 0x28, 0x8c, 0x1d, 0x04, 0xbd, 0x48, 0xda, 0xc6, 0x58, 0x4c,
+	0xf3, 0x64, 0x49, 0x3a, 0xd8, 0xd5, 0xaf, 0xb0, 0x2f, 0xb2, 0x8f, 0xe1, 0x51, 0xf0, 0x0b, 0x94,
+	0xe2, 0x07, 0x91, 0x36, 0x73, 0x50, 0x77, 0xf2, 0x96, 0xe7, 0xf9, 0xe5, 0xff, 0xcb, 0x43, 0x1e,
+	0xef, 0x38, 0xa6, 0xc9, 0x6b, 0xa1, 0x9e, 0x72, 0x2a, 0x29, 0x67, 0x1a, 0x2b, 0x0d, 0x16, 0xfc,
+	0xc3, 0x76, 0x37, 0x18, 0x28, 0xba, 0x12, 0x40, 0x53, 0x87, 0x83, 0x33, 0x0e, 0xc0, 0x05, 0x23,
+	0x54, 0x65, 0x84, 0x4a, 0x09, 0x96, 0xda, 0x0c, 0xa4, 0xd9, 0xd2, 0xbe, 0x8a, 0x09, 0x5f, 0x08,
+	0x57, 0x5d, 0xac, 0x3b, 0x5e, 0x77, 0xda, 0xd8, 0xfc, 0xd9, 0xee, 0x34, 0xc4, 0x3f, 0x42, 0xd7,
+	0xc0, 0x73, 0xb6, 0x28, 0x98, 0xb1, 0xc1, 0xc9, 0x6f, 0x70, 0x23, 0x9f, 0xc1, 0x44, 0xa7, 0x9b,
+	0x72, 0x84, 0xde, 0x3e, 0xbf, 0xd6, 0x9d, 0x7e, 0xd4, 0x23, 0x6e, 0xb8, 0x09, 0x1a, 0xfb, 0xf7,
+	0xde, 0xc1, 0x6d, 0x66, 0xac, 0xbb, 0x6b, 0xfe, 0xac, 0x1d, 0x36, 0xca, 0xa3, 0xa8, 0xbf, 0x55,
+	0x12, 0x91, 0x19, 0x5b, 0x7b, 0x1f, 0xbc, 0xde, 0x9c, 0x19, 0x0b, 0x9a, 0xf9, 0xe1, 0xbe, 0xb3,
+	0x01, 0xfb, 0xea, 0x2b, 0xc8, 0x73, 0x90, 0xf8, 0x3a, 0x57, 0x76, 0x15, 0x0d, 0x77, 0x13, 0x0f,
+	0xa2, 0xff, 0x44, 0xbb, 0xcc, 0x04, 0x8d, 0x83, 0x7f, 0x9b, 0x72, 0xd4, 0x99, 0x8a, 0xf7, 0x2a,
+	0x44, 0x1f, 0x55, 0x88, 0xca, 0x2a, 0x44, 0xde, 0x39, 0x68, 0x8e, 0x97, 0x29, 0xa5, 0x06, 0x2f,
+	0xa9, 0x48, 0x71, 0xfb, 0xf7, 0xa7, 0x03, 0xf7, 0xf2, 0x9d, 0x2b, 0x67, 0xe8, 0x11, 0xf3, 0xcc,
+	0xbe, 0x14, 0x31, 0x4e, 0x20, 0x27, 0x4d, 0x8c, 0xd4, 0xb1, 0x7a, 0x1d, 0x86, 0x70, 0xad, 0x12,
+	0xd2, 0x16, 0xc4, 0xdd, 0x66, 0x15, 0x97, 0xdf, 0x03, 0x00, 0xf8, 0x11, 0x05, 0x7c, 0xed, 0x01,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BackupClient is the client API for Backup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BackupClient interface {
+	Backup(ctx context.Context, in *payload.Backup_Request, opts ...grpc.CallOption) (*payload.Backup_Infos, error)
+	ListBackups(ctx context.Context, in *payload.Backup_Request, opts ...grpc.CallOption) (*payload.Backup_Infos, error)
+	Restore(ctx context.Context, in *payload.Backup_RestoreRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error)
+}
+
+type backupClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupClient(cc *grpc.ClientConn) BackupClient {
+	return &backupClient{cc}
+}
+
+func (c *backupClient) Backup(ctx context.Context, in *payload.Backup_Request, opts ...grpc.CallOption) (*payload.Backup_Infos, error) {
+	out := new(payload.Backup_Infos)
+	err := c.cc.Invoke(ctx, "/backup_manager.Backup/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupClient) ListBackups(ctx context.Context, in *payload.Backup_Request, opts ...grpc.CallOption) (*payload.Backup_Infos, error) {
+	out := new(payload.Backup_Infos)
+	err := c.cc.Invoke(ctx, "/backup_manager.Backup/ListBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupClient) Restore(ctx context.Context, in *payload.Backup_RestoreRequest, opts ...grpc.CallOption) (*payload.Common_Empty, error) {
+	out := new(payload.Common_Empty)
+	err := c.cc.Invoke(ctx, "/backup_manager.Backup/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackupServer is the server API for Backup service.
+type BackupServer interface {
+	Backup(context.Context, *payload.Backup_Request) (*payload.Backup_Infos, error)
+	ListBackups(context.Context, *payload.Backup_Request) (*payload.Backup_Infos, error)
+	Restore(context.Context, *payload.Backup_RestoreRequest) (*payload.Common_Empty, error)
+}
+
+// UnimplementedBackupServer can be embedded to have forward compatible implementations.
+type UnimplementedBackupServer struct {
+}
+
+func (*UnimplementedBackupServer) Backup(ctx context.Context, req *payload.Backup_Request) (*payload.Backup_Infos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedBackupServer) ListBackups(ctx context.Context, req *payload.Backup_Request) (*payload.Backup_Infos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (*UnimplementedBackupServer) Restore(ctx context.Context, req *payload.Backup_RestoreRequest) (*payload.Common_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+
+func RegisterBackupServer(s *grpc.Server, srv BackupServer) {
+	s.RegisterService(&_Backup_serviceDesc, srv)
+}
+
+func _Backup_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Backup_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/backup_manager.Backup/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServer).Backup(ctx, req.(*payload.Backup_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backup_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Backup_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/backup_manager.Backup/ListBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServer).ListBackups(ctx, req.(*payload.Backup_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backup_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Backup_RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/backup_manager.Backup/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServer).Restore(ctx, req.(*payload.Backup_RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Backup_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "backup_manager.Backup",
+	HandlerType: (*BackupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Backup",
+			Handler:    _Backup_Backup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _Backup_ListBackups_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Backup_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backup_manager.proto",
 }
@@ -13,6 +13,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+import payload_pb2 as payload__pb2
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from pb import gql_pb2 as pb_dot_gql__pb2
 
@@ -21,10 +22,10 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='backup_manager.proto',
   package='backup_manager',
   syntax='proto3',
-  serialized_options=_b('Z.github.com/vdaas/vald/apis/grpc/backup_manager'),
-  serialized_pb=_b('\n\x14\x62\x61\x63kup_manager.proto\x12\x0e\x62\x61\x63kup_manager\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.protoB0Z.github.com/vdaas/vald/apis/grpc/backup_managerb\x06proto3')
+  serialized_options=_b('\n\035org.vdaas.vald.backup_managerB\rBackupManagerP\001Z.github.com/vdaas/vald/apis/grpc/backup_manager'),
+  serialized_pb=_b('\n\x14\x62\x61\x63kup_manager.proto\x12\x0e\x62\x61\x63kup_manager\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\x93\x02\n\x06\x42\x61\x63kup\x12P\n\x06\x42\x61\x63kup\x12\x17.payload.Backup.Request\x1a\x15.payload.Backup.Infos\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/backup:\x01*\xb0\xe0\x1f\x01\x12V\n\x0bListBackups\x12\x17.payload.Backup.Request\x1a\x15.payload.Backup.Infos\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0c/backup/list:\x01*\x12Y\n\x07Restore\x12\x1e.payload.Backup.RestoreRequest\x1a\x15.payload.Common.Empty\"\x17\x82\xd3\xe4\x93\x02\r\"\x08/restore:\x01*\xb0\xe0\x1f\x01\x1a\x04\xb0\xe0\x1f\x02\x42`\n\x1dorg.vdaas.vald.backup_managerB\rBackupManagerP\x01Z.github.com/vdaas/vald/apis/grpc/backup_managerb\x06proto3')
   ,
-  dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
+  dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
 
 
@@ -32,4 +33,46 @@ _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 
 DESCRIPTOR._options = None
+
+_BACKUP = _descriptor.ServiceDescriptor(
+  name='Backup',
+  full_name='backup_manager.Backup',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=_b('\260\340\037\002'),
+  serialized_start=100,
+  serialized_end=375,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Backup',
+    full_name='backup_manager.Backup.Backup',
+    index=0,
+    containing_service=None,
+    input_type=payload__pb2._BACKUP_REQUEST,
+    output_type=payload__pb2._BACKUP_INFOS,
+    serialized_options=_b('\202\323\344\223\002\014\"\007/backup:\001*\260\340\037\001'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListBackups',
+    full_name='backup_manager.Backup.ListBackups',
+    index=1,
+    containing_service=None,
+    input_type=payload__pb2._BACKUP_REQUEST,
+    output_type=payload__pb2._BACKUP_INFOS,
+    serialized_options=_b('\202\323\344\223\002\021\"\014/backup/list:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Restore',
+    full_name='backup_manager.Backup.Restore',
+    index=2,
+    containing_service=None,
+    input_type=payload__pb2._BACKUP_RESTOREREQUEST,
+    output_type=payload__pb2._COMMON_EMPTY,
+    serialized_options=_b('\202\323\344\223\002\r\"\010/restore:\001*\260\340\037\001'),
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_BACKUP)
+
+DESCRIPTOR.services_by_name['Backup'] = _BACKUP
+
 # @@protoc_insertion_point(module_scope)
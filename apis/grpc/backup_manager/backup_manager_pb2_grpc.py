@@ -1,3 +1,80 @@
 # Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
 import grpc
 
+import payload_pb2 as payload__pb2
+
+
+class BackupStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.Backup = channel.unary_unary(
+        '/backup_manager.Backup/Backup',
+        request_serializer=payload__pb2.Backup.Request.SerializeToString,
+        response_deserializer=payload__pb2.Backup.Infos.FromString,
+        )
+    self.ListBackups = channel.unary_unary(
+        '/backup_manager.Backup/ListBackups',
+        request_serializer=payload__pb2.Backup.Request.SerializeToString,
+        response_deserializer=payload__pb2.Backup.Infos.FromString,
+        )
+    self.Restore = channel.unary_unary(
+        '/backup_manager.Backup/Restore',
+        request_serializer=payload__pb2.Backup.RestoreRequest.SerializeToString,
+        response_deserializer=payload__pb2.Common.Empty.FromString,
+        )
+
+
+class BackupServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def Backup(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListBackups(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Restore(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_BackupServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Backup': grpc.unary_unary_rpc_method_handler(
+          servicer.Backup,
+          request_deserializer=payload__pb2.Backup.Request.FromString,
+          response_serializer=payload__pb2.Backup.Infos.SerializeToString,
+      ),
+      'ListBackups': grpc.unary_unary_rpc_method_handler(
+          servicer.ListBackups,
+          request_deserializer=payload__pb2.Backup.Request.FromString,
+          response_serializer=payload__pb2.Backup.Infos.SerializeToString,
+      ),
+      'Restore': grpc.unary_unary_rpc_method_handler(
+          servicer.Restore,
+          request_deserializer=payload__pb2.Backup.RestoreRequest.FromString,
+          response_serializer=payload__pb2.Common.Empty.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'backup_manager.Backup', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
@@ -30,22 +30,23 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("discoverer.proto", fileDescriptor_9fa655cb815aa581) }
 
 var fileDescriptor_9fa655cb815aa581 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
+	// 252 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0xc9, 0x2c, 0x4e,
 	0xce, 0x2f, 0x4b, 0x2d, 0x4a, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0x88,
 	0x48, 0xf1, 0x16, 0x24, 0x56, 0xe6, 0xe4, 0x27, 0xa6, 0x40, 0xa4, 0xa4, 0x64, 0xd2, 0xf3, 0xf3,
 	0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32,
-	0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0x3c, 0x05, 0x49, 0xfa, 0xe9, 0x85, 0x39, 0x10, 0x9e, 0x51, 0x1c,
-	0x17, 0x97, 0x0b, 0xdc, 0x20, 0x21, 0x2f, 0x2e, 0x0e, 0x18, 0x4f, 0x48, 0x54, 0x0f, 0x66, 0xaa,
-	0x73, 0x7e, 0x6e, 0x6e, 0x7e, 0x9e, 0x9e, 0x6b, 0x6e, 0x41, 0x49, 0xa5, 0x94, 0x08, 0x5c, 0xd8,
-	0x33, 0x2f, 0x2d, 0x5f, 0xcf, 0x31, 0x3d, 0x35, 0xaf, 0xa4, 0x58, 0x49, 0xb0, 0xe9, 0xf2, 0x93,
-	0xc9, 0x4c, 0xdc, 0x42, 0x9c, 0xfa, 0x30, 0x67, 0x49, 0xb1, 0x6c, 0x78, 0x20, 0xcf, 0xe4, 0x94,
-	0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x72, 0x49, 0xe6,
-	0x17, 0xa5, 0xeb, 0x95, 0xa5, 0x24, 0x26, 0x16, 0xeb, 0x95, 0x25, 0xe6, 0xa4, 0xe8, 0x21, 0xfc,
-	0xe0, 0x84, 0xe4, 0x8c, 0x00, 0xc6, 0x28, 0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0xb0, 0x7a, 0x7d, 0x90, 0x7a, 0x90, 0x8f, 0x8a, 0xf5, 0xd3, 0x8b, 0x0a, 0x92,
-	0xf5, 0x11, 0x3a, 0x93, 0xd8, 0xc0, 0x3e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x3b,
-	0x2c, 0xb6, 0x24, 0x01, 0x00, 0x00,
+	0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0x3c, 0x05, 0x49, 0xfa, 0xe9, 0x85, 0x39, 0x10, 0x9e, 0xd1, 0x1c,
+	0x46, 0x2e, 0x2e, 0x17, 0xb8, 0x49, 0x42, 0x5e, 0x5c, 0x1c, 0x30, 0x9e, 0x90, 0xa8, 0x1e, 0xcc,
+	0x58, 0xe7, 0xfc, 0xdc, 0xdc, 0xfc, 0x3c, 0x3d, 0xd7, 0xdc, 0x82, 0x92, 0x4a, 0x29, 0x11, 0xb8,
+	0xb0, 0x67, 0x5e, 0x5a, 0xbe, 0x9e, 0x63, 0x7a, 0x6a, 0x5e, 0x49, 0xb1, 0x92, 0x60, 0xd3, 0xe5,
+	0x27, 0x93, 0x99, 0xb8, 0x85, 0x38, 0xf5, 0x61, 0xee, 0x12, 0xb2, 0xe1, 0x62, 0x0d, 0x4f, 0x2c,
+	0x49, 0xce, 0xc0, 0x65, 0x90, 0x04, 0x16, 0x83, 0x5c, 0xcb, 0x52, 0xf3, 0x4a, 0x94, 0x18, 0x0c,
+	0x18, 0xa5, 0x58, 0x36, 0x3c, 0x90, 0x67, 0x72, 0x4a, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
+	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0xb9, 0x24, 0xf3, 0x8b, 0xd2, 0xf5, 0xca, 0x52, 0x12, 0x13,
+	0x8b, 0xf5, 0xca, 0x12, 0x73, 0x52, 0xf4, 0x10, 0x41, 0xe0, 0x84, 0xe4, 0x89, 0x00, 0xc6, 0x28,
+	0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0, 0x7a, 0x7d, 0x90,
+	0x7a, 0x50, 0x80, 0x14, 0xeb, 0xa7, 0x17, 0x15, 0x24, 0xeb, 0x23, 0x74, 0x26, 0xb1, 0x81, 0x03,
+	0xc2, 0x18, 0x30, 0x00, 0xd4, 0x27, 0xb3, 0x64, 0x63, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -61,6 +62,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type DiscovererClient interface {
 	Discover(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Info_Agents, error)
+	Watch(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (Discoverer_WatchClient, error)
 }
 
 type discovererClient struct {
@@ -80,9 +82,42 @@ func (c *discovererClient) Discover(ctx context.Context, in *payload.Common_Empt
 	return out, nil
 }
 
+func (c *discovererClient) Watch(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (Discoverer_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Discoverer_serviceDesc.Streams[0], "/discoverer.Discoverer/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &discovererWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Discoverer_WatchClient interface {
+	Recv() (*payload.Info_AgentEvent, error)
+	grpc.ClientStream
+}
+
+type discovererWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *discovererWatchClient) Recv() (*payload.Info_AgentEvent, error) {
+	m := new(payload.Info_AgentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DiscovererServer is the server API for Discoverer service.
 type DiscovererServer interface {
 	Discover(context.Context, *payload.Common_Empty) (*payload.Info_Agents, error)
+	Watch(*payload.Common_Empty, Discoverer_WatchServer) error
 }
 
 // UnimplementedDiscovererServer can be embedded to have forward compatible implementations.
@@ -92,6 +127,9 @@ type UnimplementedDiscovererServer struct {
 func (*UnimplementedDiscovererServer) Discover(ctx context.Context, req *payload.Common_Empty) (*payload.Info_Agents, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Discover not implemented")
 }
+func (*UnimplementedDiscovererServer) Watch(req *payload.Common_Empty, srv Discoverer_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 
 func RegisterDiscovererServer(s *grpc.Server, srv DiscovererServer) {
 	s.RegisterService(&_Discoverer_serviceDesc, srv)
@@ -115,6 +153,27 @@ func _Discoverer_Discover_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Discoverer_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(payload.Common_Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiscovererServer).Watch(m, &discovererWatchServer{stream})
+}
+
+type Discoverer_WatchServer interface {
+	Send(*payload.Info_AgentEvent) error
+	grpc.ServerStream
+}
+
+type discovererWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *discovererWatchServer) Send(m *payload.Info_AgentEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Discoverer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "discoverer.Discoverer",
 	HandlerType: (*DiscovererServer)(nil),
@@ -124,6 +183,12 @@ var _Discoverer_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Discoverer_Discover_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Discoverer_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "discoverer.proto",
 }
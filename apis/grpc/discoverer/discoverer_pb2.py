@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='discoverer',
   syntax='proto3',
   serialized_options=_b('\n\031org.vdaas.vald.discovererB\nDiscovererP\001Z*github.com/vdaas/vald/apis/grpc/discoverer'),
-  serialized_pb=_b('\n\x10\x64iscoverer.proto\x12\ndiscoverer\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2^\n\nDiscoverer\x12J\n\x08\x44iscover\x12\x15.payload.Common.Empty\x1a\x14.payload.Info.Agents\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/discover\x1a\x04\xb0\xe0\x1f\x02\x42U\n\x19org.vdaas.vald.discovererB\nDiscovererP\x01Z*github.com/vdaas/vald/apis/grpc/discovererb\x06proto3')
+  serialized_pb=_b('\n\x10\x64iscoverer.proto\x12\ndiscoverer\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\x9c\x01\n\nDiscoverer\x12J\n\x08\x44iscover\x12\x15.payload.Common.Empty\x1a\x14.payload.Info.Agents\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/discover\x12<\n\x05Watch\x12\x15.payload.Common.Empty\x1a\x18.payload.Info.AgentEvent\"\x00\x30\x01\x1a\x04\xb0\xe0\x1f\x02\x42U\n\x19org.vdaas.vald.discovererB\nDiscovererP\x01Z*github.com/vdaas/vald/apis/grpc/discovererb\x06proto3')
   ,
   dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
@@ -40,8 +40,8 @@ _DISCOVERER = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=_b('\260\340\037\002'),
-  serialized_start=91,
-  serialized_end=185,
+  serialized_start=92,
+  serialized_end=248,
   methods=[
   _descriptor.MethodDescriptor(
     name='Discover',
@@ -52,6 +52,15 @@ _DISCOVERER = _descriptor.ServiceDescriptor(
     output_type=payload__pb2._INFO_AGENTS,
     serialized_options=_b('\202\323\344\223\002\013\022\t/discover'),
   ),
+  _descriptor.MethodDescriptor(
+    name='Watch',
+    full_name='discoverer.Discoverer.Watch',
+    index=1,
+    containing_service=None,
+    input_type=payload__pb2._COMMON_EMPTY,
+    output_type=payload__pb2._INFO_AGENTEVENT,
+    serialized_options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_DISCOVERER)
 
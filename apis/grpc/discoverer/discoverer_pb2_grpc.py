@@ -19,6 +19,11 @@ class DiscovererStub(object):
         request_serializer=payload__pb2.Common.Empty.SerializeToString,
         response_deserializer=payload__pb2.Info.Agents.FromString,
         )
+    self.Watch = channel.unary_stream(
+        '/discoverer.Discoverer/Watch',
+        request_serializer=payload__pb2.Common.Empty.SerializeToString,
+        response_deserializer=payload__pb2.Info.AgentEvent.FromString,
+        )
 
 
 class DiscovererServicer(object):
@@ -32,6 +37,13 @@ class DiscovererServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Watch(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_DiscovererServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -40,6 +52,11 @@ def add_DiscovererServicer_to_server(servicer, server):
           request_deserializer=payload__pb2.Common.Empty.FromString,
           response_serializer=payload__pb2.Info.Agents.SerializeToString,
       ),
+      'Watch': grpc.unary_stream_rpc_method_handler(
+          servicer.Watch,
+          request_deserializer=payload__pb2.Common.Empty.FromString,
+          response_serializer=payload__pb2.Info.AgentEvent.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'discoverer.Discoverer', rpc_method_handlers)
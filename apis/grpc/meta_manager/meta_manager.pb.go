@@ -4,10 +4,15 @@
 package meta_manager
 
 import (
+	context "context"
 	fmt "fmt"
 	_ "github.com/danielvladco/go-proto-gql/pb"
 	proto "github.com/gogo/protobuf/proto"
+	payload "github.com/vdaas/vald/apis/grpc/payload"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -25,14 +30,287 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("meta_manager.proto", fileDescriptor_7eeda2eaaabc28d3) }
 
 var fileDescriptor_7eeda2eaaabc28d3 = []byte{
-	// 138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0x4d, 0x2d, 0x49,
-	0x8c, 0xcf, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x41, 0x16, 0x93, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f,
-	0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xa8, 0x95, 0xe2, 0x29, 0x48,
-	0xd2, 0x4f, 0x2f, 0xcc, 0x81, 0xf0, 0x9c, 0xac, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0xfd, 0xb2, 0x94, 0xc4, 0xc4, 0x62, 0xfd, 0xb2, 0xc4, 0x9c, 0x14, 0x90, 0x41, 0xc5, 0xfa,
-	0xe9, 0x45, 0x05, 0xc9, 0xfa, 0xc8, 0xf6, 0x24, 0xb1, 0x81, 0x8d, 0x30, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xbd, 0x25, 0xd3, 0xf4, 0x92, 0x00, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0xbf, 0xf4, 0x53, 0x0b, 0x6b, 0x44, 0x58, 0x6b, 0x0f, 0x51, 0x5a, 0xc8, 0xb1, 0xc8,
+	0x2e, 0xe8, 0x4d, 0x04, 0xa5, 0x56, 0x8a, 0x60, 0xa9, 0xe0, 0xcd, 0x8b, 0x4c, 0x9a, 0x75, 0x8d,
+	0x24, 0x99, 0xb8, 0xd9, 0x16, 0x44, 0xbc, 0xf8, 0x0a, 0xbe, 0x48, 0x1f, 0xc0, 0x07, 0xf0, 0x28,
+	0xf8, 0x02, 0xa5, 0xf8, 0x20, 0x92, 0x34, 0x2d, 0x0b, 0x26, 0xc7, 0xcc, 0xe4, 0xff, 0x9b, 0x1f,
+	0xb3, 0x43, 0x68, 0x24, 0x34, 0xdc, 0x45, 0x10, 0x83, 0x14, 0x8a, 0x25, 0x0a, 0x35, 0x52, 0xdb,
+	0xac, 0x39, 0x5b, 0x09, 0x3c, 0x87, 0x08, 0xfe, 0xa2, 0xe9, 0xec, 0x4b, 0x44, 0x19, 0x0a, 0x0e,
+	0x49, 0xc0, 0x21, 0x8e, 0x51, 0x83, 0x0e, 0x30, 0x4e, 0x8b, 0xae, 0x9d, 0x78, 0x5c, 0x3e, 0x85,
+	0x8b, 0xaf, 0xc3, 0x8f, 0xff, 0x64, 0x73, 0x20, 0x34, 0x0c, 0x16, 0x28, 0xda, 0x27, 0xf5, 0xbe,
+	0xd0, 0x59, 0x85, 0x52, 0xb6, 0xc4, 0x0e, 0xbd, 0x47, 0x31, 0xd2, 0xec, 0xb2, 0xe7, 0x34, 0x56,
+	0xb5, 0xec, 0x97, 0xa2, 0xe1, 0xd2, 0xb7, 0xef, 0x9f, 0xf7, 0x9a, 0x4d, 0x09, 0xcf, 0xb4, 0xf8,
+	0x4b, 0xe0, 0xbf, 0xd2, 0x13, 0x62, 0x0f, 0xc6, 0xa1, 0x0e, 0x96, 0xb4, 0x9d, 0xbf, 0xb4, 0xd4,
+	0xd9, 0x2d, 0xc3, 0xa5, 0xee, 0x3f, 0x7a, 0x45, 0xea, 0x37, 0x45, 0xb0, 0x74, 0xa4, 0x91, 0x3c,
+	0xc7, 0x28, 0xc2, 0x98, 0x5d, 0x28, 0x85, 0xca, 0x6d, 0x4c, 0x67, 0x6d, 0x2b, 0xb7, 0x21, 0xee,
+	0x7a, 0x6e, 0x73, 0x6c, 0x75, 0xe8, 0x69, 0xe1, 0xb2, 0x44, 0x96, 0x8f, 0x75, 0x9a, 0xa5, 0xcc,
+	0x4c, 0x67, 0x48, 0x48, 0x4f, 0x84, 0x42, 0x8b, 0xca, 0xc5, 0x54, 0xf8, 0x34, 0x57, 0x3e, 0x76,
+	0xc7, 0xdc, 0xce, 0x19, 0xd9, 0xce, 0x8d, 0x0c, 0x6a, 0xe9, 0x82, 0x2a, 0x95, 0x9c, 0xb5, 0xe9,
+	0xac, 0x5d, 0xeb, 0xde, 0x7f, 0xce, 0x5b, 0xd6, 0xd7, 0xbc, 0x65, 0xcd, 0xe6, 0x2d, 0x8b, 0xec,
+	0xa1, 0x92, 0x6c, 0xe2, 0x03, 0xa4, 0x6c, 0x02, 0xa1, 0xcf, 0xcc, 0x23, 0xe9, 0x9a, 0xcf, 0x7c,
+	0x6d, 0xdd, 0x1e, 0xc8, 0x40, 0x3f, 0x8c, 0x3d, 0x36, 0xc2, 0x88, 0xe7, 0x11, 0x9e, 0x45, 0xb2,
+	0x9b, 0x49, 0xb9, 0x54, 0xc9, 0x88, 0x9b, 0x61, 0x6f, 0x23, 0xbf, 0x96, 0xa3, 0xdf, 0x01, 0x00,
+	0x83, 0xc1, 0x8d, 0xb8, 0x8c, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MetaManagerClient is the client API for MetaManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MetaManagerClient interface {
+	GetMeta(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Meta_Object, error)
+	MultiGetMeta(ctx context.Context, in *payload.Object_IDs, opts ...grpc.CallOption) (*payload.Meta_Objects, error)
+	SetMeta(ctx context.Context, in *payload.Meta_Object, opts ...grpc.CallOption) (*payload.Common_Error, error)
+	MultiSetMeta(ctx context.Context, in *payload.Meta_Objects, opts ...grpc.CallOption) (*payload.Common_Errors, error)
+	DeleteMeta(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Common_Error, error)
+	MultiDeleteMeta(ctx context.Context, in *payload.Object_IDs, opts ...grpc.CallOption) (*payload.Common_Errors, error)
+}
+
+type metaManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMetaManagerClient(cc *grpc.ClientConn) MetaManagerClient {
+	return &metaManagerClient{cc}
+}
+
+func (c *metaManagerClient) GetMeta(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Meta_Object, error) {
+	out := new(payload.Meta_Object)
+	err := c.cc.Invoke(ctx, "/meta_manager.MetaManager/GetMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaManagerClient) MultiGetMeta(ctx context.Context, in *payload.Object_IDs, opts ...grpc.CallOption) (*payload.Meta_Objects, error) {
+	out := new(payload.Meta_Objects)
+	err := c.cc.Invoke(ctx, "/meta_manager.MetaManager/MultiGetMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaManagerClient) SetMeta(ctx context.Context, in *payload.Meta_Object, opts ...grpc.CallOption) (*payload.Common_Error, error) {
+	out := new(payload.Common_Error)
+	err := c.cc.Invoke(ctx, "/meta_manager.MetaManager/SetMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaManagerClient) MultiSetMeta(ctx context.Context, in *payload.Meta_Objects, opts ...grpc.CallOption) (*payload.Common_Errors, error) {
+	out := new(payload.Common_Errors)
+	err := c.cc.Invoke(ctx, "/meta_manager.MetaManager/MultiSetMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaManagerClient) DeleteMeta(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Common_Error, error) {
+	out := new(payload.Common_Error)
+	err := c.cc.Invoke(ctx, "/meta_manager.MetaManager/DeleteMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaManagerClient) MultiDeleteMeta(ctx context.Context, in *payload.Object_IDs, opts ...grpc.CallOption) (*payload.Common_Errors, error) {
+	out := new(payload.Common_Errors)
+	err := c.cc.Invoke(ctx, "/meta_manager.MetaManager/MultiDeleteMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetaManagerServer is the server API for MetaManager service.
+type MetaManagerServer interface {
+	GetMeta(context.Context, *payload.Object_ID) (*payload.Meta_Object, error)
+	MultiGetMeta(context.Context, *payload.Object_IDs) (*payload.Meta_Objects, error)
+	SetMeta(context.Context, *payload.Meta_Object) (*payload.Common_Error, error)
+	MultiSetMeta(context.Context, *payload.Meta_Objects) (*payload.Common_Errors, error)
+	DeleteMeta(context.Context, *payload.Object_ID) (*payload.Common_Error, error)
+	MultiDeleteMeta(context.Context, *payload.Object_IDs) (*payload.Common_Errors, error)
+}
+
+// UnimplementedMetaManagerServer can be embedded to have forward compatible implementations.
+type UnimplementedMetaManagerServer struct {
+}
+
+func (*UnimplementedMetaManagerServer) GetMeta(ctx context.Context, req *payload.Object_ID) (*payload.Meta_Object, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeta not implemented")
+}
+func (*UnimplementedMetaManagerServer) MultiGetMeta(ctx context.Context, req *payload.Object_IDs) (*payload.Meta_Objects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiGetMeta not implemented")
+}
+func (*UnimplementedMetaManagerServer) SetMeta(ctx context.Context, req *payload.Meta_Object) (*payload.Common_Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMeta not implemented")
+}
+func (*UnimplementedMetaManagerServer) MultiSetMeta(ctx context.Context, req *payload.Meta_Objects) (*payload.Common_Errors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSetMeta not implemented")
+}
+func (*UnimplementedMetaManagerServer) DeleteMeta(ctx context.Context, req *payload.Object_ID) (*payload.Common_Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeta not implemented")
+}
+func (*UnimplementedMetaManagerServer) MultiDeleteMeta(ctx context.Context, req *payload.Object_IDs) (*payload.Common_Errors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiDeleteMeta not implemented")
+}
+
+func RegisterMetaManagerServer(s *grpc.Server, srv MetaManagerServer) {
+	s.RegisterService(&_MetaManager_serviceDesc, srv)
+}
+
+func _MetaManager_GetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Object_ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaManagerServer).GetMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta_manager.MetaManager/GetMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaManagerServer).GetMeta(ctx, req.(*payload.Object_ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaManager_MultiGetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Object_IDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaManagerServer).MultiGetMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta_manager.MetaManager/MultiGetMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaManagerServer).MultiGetMeta(ctx, req.(*payload.Object_IDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaManager_SetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Meta_Object)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaManagerServer).SetMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta_manager.MetaManager/SetMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaManagerServer).SetMeta(ctx, req.(*payload.Meta_Object))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaManager_MultiSetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Meta_Objects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaManagerServer).MultiSetMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta_manager.MetaManager/MultiSetMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaManagerServer).MultiSetMeta(ctx, req.(*payload.Meta_Objects))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaManager_DeleteMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Object_ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaManagerServer).DeleteMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta_manager.MetaManager/DeleteMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaManagerServer).DeleteMeta(ctx, req.(*payload.Object_ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaManager_MultiDeleteMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Object_IDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaManagerServer).MultiDeleteMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta_manager.MetaManager/MultiDeleteMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaManagerServer).MultiDeleteMeta(ctx, req.(*payload.Object_IDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MetaManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "meta_manager.MetaManager",
+	HandlerType: (*MetaManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMeta",
+			Handler:    _MetaManager_GetMeta_Handler,
+		},
+		{
+			MethodName: "MultiGetMeta",
+			Handler:    _MetaManager_MultiGetMeta_Handler,
+		},
+		{
+			MethodName: "SetMeta",
+			Handler:    _MetaManager_SetMeta_Handler,
+		},
+		{
+			MethodName: "MultiSetMeta",
+			Handler:    _MetaManager_MultiSetMeta_Handler,
+		},
+		{
+			MethodName: "DeleteMeta",
+			Handler:    _MetaManager_DeleteMeta_Handler,
+		},
+		{
+			MethodName: "MultiDeleteMeta",
+			Handler:    _MetaManager_MultiDeleteMeta_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "meta_manager.proto",
 }
@@ -13,6 +13,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+import payload_pb2 as payload__pb2
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from pb import gql_pb2 as pb_dot_gql__pb2
 
@@ -21,10 +22,10 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='meta_manager.proto',
   package='meta_manager',
   syntax='proto3',
-  serialized_options=_b('Z,github.com/vdaas/vald/apis/grpc/meta_manager'),
-  serialized_pb=_b('\n\x12meta_manager.proto\x12\x0cmeta_manager\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.protoB.Z,github.com/vdaas/vald/apis/grpc/meta_managerb\x06proto3')
+  serialized_options=_b('\n\033org.vdaas.vald.meta_managerB\013MetaManagerP\001Z,github.com/vdaas/vald/apis/grpc/meta_manager'),
+  serialized_pb=_b('\n\x12meta_manager.proto\x12\x0cmeta_manager\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\xbc\x03\n\x0bMetaManager\x12G\n\x07GetMeta\x12\x12.payload.Object.ID\x1a\x14.payload.Meta.Object\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/meta/{id}\x12<\n\x0cMultiGetMeta\x12\x13.payload.Object.IDs\x1a\x15.payload.Meta.Objects\"\x00\x12L\n\x07SetMeta\x12\x14.payload.Meta.Object\x1a\x15.payload.Common.Error\"\x14\x82\xd3\xe4\x93\x02\n\"\x05/meta:\x01*\xb0\xe0\x1f\x01\x12?\n\x0cMultiSetMeta\x12\x15.payload.Meta.Objects\x1a\x16.payload.Common.Errors\"\x00\x12O\n\nDeleteMeta\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c*\n/meta/{id}\xb0\xe0\x1f\x01\x12@\n\x0fMultiDeleteMeta\x12\x13.payload.Object.IDs\x1a\x16.payload.Common.Errors\"\x00\x1a\x04\xb0\xe0\x1f\x02\x42Z\n\x1borg.vdaas.vald.meta_managerB\x0bMetaManagerP\x01Z,github.com/vdaas/vald/apis/grpc/meta_managerb\x06proto3')
   ,
-  dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
+  dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
 
 
@@ -32,4 +33,73 @@ _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 
 DESCRIPTOR._options = None
+
+_METAMANAGER = _descriptor.ServiceDescriptor(
+  name='MetaManager',
+  full_name='meta_manager.MetaManager',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=_b('\260\340\037\002'),
+  serialized_start=96,
+  serialized_end=540,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='GetMeta',
+    full_name='meta_manager.MetaManager.GetMeta',
+    index=0,
+    containing_service=None,
+    input_type=payload__pb2._OBJECT_ID,
+    output_type=payload__pb2._META_OBJECT,
+    serialized_options=_b('\202\323\344\223\002\014\022\n/meta/{id}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='MultiGetMeta',
+    full_name='meta_manager.MetaManager.MultiGetMeta',
+    index=1,
+    containing_service=None,
+    input_type=payload__pb2._OBJECT_IDS,
+    output_type=payload__pb2._META_OBJECTS,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SetMeta',
+    full_name='meta_manager.MetaManager.SetMeta',
+    index=2,
+    containing_service=None,
+    input_type=payload__pb2._META_OBJECT,
+    output_type=payload__pb2._COMMON_ERROR,
+    serialized_options=_b('\202\323\344\223\002\n\"\005/meta:\001*\260\340\037\001'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='MultiSetMeta',
+    full_name='meta_manager.MetaManager.MultiSetMeta',
+    index=3,
+    containing_service=None,
+    input_type=payload__pb2._META_OBJECTS,
+    output_type=payload__pb2._COMMON_ERRORS,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteMeta',
+    full_name='meta_manager.MetaManager.DeleteMeta',
+    index=4,
+    containing_service=None,
+    input_type=payload__pb2._OBJECT_ID,
+    output_type=payload__pb2._COMMON_ERROR,
+    serialized_options=_b('\202\323\344\223\002\014*\n/meta/{id}\260\340\037\001'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='MultiDeleteMeta',
+    full_name='meta_manager.MetaManager.MultiDeleteMeta',
+    index=5,
+    containing_service=None,
+    input_type=payload__pb2._OBJECT_IDS,
+    output_type=payload__pb2._COMMON_ERRORS,
+    serialized_options=None,
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_METAMANAGER)
+
+DESCRIPTOR.services_by_name['MetaManager'] = _METAMANAGER
+
 # @@protoc_insertion_point(module_scope)
@@ -1,3 +1,131 @@
 # Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
 import grpc
 
+import payload_pb2 as payload__pb2
+
+
+class MetaManagerStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.GetMeta = channel.unary_unary(
+        '/meta_manager.MetaManager/GetMeta',
+        request_serializer=payload__pb2.Object.ID.SerializeToString,
+        response_deserializer=payload__pb2.Meta.Object.FromString,
+        )
+    self.MultiGetMeta = channel.unary_unary(
+        '/meta_manager.MetaManager/MultiGetMeta',
+        request_serializer=payload__pb2.Object.IDs.SerializeToString,
+        response_deserializer=payload__pb2.Meta.Objects.FromString,
+        )
+    self.SetMeta = channel.unary_unary(
+        '/meta_manager.MetaManager/SetMeta',
+        request_serializer=payload__pb2.Meta.Object.SerializeToString,
+        response_deserializer=payload__pb2.Common.Error.FromString,
+        )
+    self.MultiSetMeta = channel.unary_unary(
+        '/meta_manager.MetaManager/MultiSetMeta',
+        request_serializer=payload__pb2.Meta.Objects.SerializeToString,
+        response_deserializer=payload__pb2.Common.Errors.FromString,
+        )
+    self.DeleteMeta = channel.unary_unary(
+        '/meta_manager.MetaManager/DeleteMeta',
+        request_serializer=payload__pb2.Object.ID.SerializeToString,
+        response_deserializer=payload__pb2.Common.Error.FromString,
+        )
+    self.MultiDeleteMeta = channel.unary_unary(
+        '/meta_manager.MetaManager/MultiDeleteMeta',
+        request_serializer=payload__pb2.Object.IDs.SerializeToString,
+        response_deserializer=payload__pb2.Common.Errors.FromString,
+        )
+
+
+class MetaManagerServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def GetMeta(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def MultiGetMeta(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetMeta(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def MultiSetMeta(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DeleteMeta(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def MultiDeleteMeta(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_MetaManagerServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'GetMeta': grpc.unary_unary_rpc_method_handler(
+          servicer.GetMeta,
+          request_deserializer=payload__pb2.Object.ID.FromString,
+          response_serializer=payload__pb2.Meta.Object.SerializeToString,
+      ),
+      'MultiGetMeta': grpc.unary_unary_rpc_method_handler(
+          servicer.MultiGetMeta,
+          request_deserializer=payload__pb2.Object.IDs.FromString,
+          response_serializer=payload__pb2.Meta.Objects.SerializeToString,
+      ),
+      'SetMeta': grpc.unary_unary_rpc_method_handler(
+          servicer.SetMeta,
+          request_deserializer=payload__pb2.Meta.Object.FromString,
+          response_serializer=payload__pb2.Common.Error.SerializeToString,
+      ),
+      'MultiSetMeta': grpc.unary_unary_rpc_method_handler(
+          servicer.MultiSetMeta,
+          request_deserializer=payload__pb2.Meta.Objects.FromString,
+          response_serializer=payload__pb2.Common.Errors.SerializeToString,
+      ),
+      'DeleteMeta': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteMeta,
+          request_deserializer=payload__pb2.Object.ID.FromString,
+          response_serializer=payload__pb2.Common.Error.SerializeToString,
+      ),
+      'MultiDeleteMeta': grpc.unary_unary_rpc_method_handler(
+          servicer.MultiDeleteMeta,
+          request_deserializer=payload__pb2.Object.IDs.FromString,
+          response_serializer=payload__pb2.Common.Errors.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'meta_manager.MetaManager', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
@@ -21,12 +21,42 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='payload',
   syntax='proto3',
   serialized_options=_b('\n\026org.vdaas.vald.payloadB\013ValdPayloadP\001Z\'github.com/vdaas/vald/apis/grpc/payload'),
-  serialized_pb=_b('\n\rpayload.proto\x12\x07payload\x1a\x17validate/validate.proto\"\xd6\x02\n\x06Search\x1aY\n\x07Request\x12&\n\x06vector\x18\x01 \x01(\x0b\x32\x16.payload.Object.Vector\x12&\n\x06\x63onfig\x18\x02 \x01(\x0b\x32\x16.payload.Search.Config\x1aS\n\tIDRequest\x12\x1e\n\x02id\x18\x01 \x01(\x0b\x32\x12.payload.Object.ID\x12&\n\x06\x63onfig\x18\x02 \x01(\x0b\x32\x16.payload.Search.Config\x1a?\n\x06\x43onfig\x12\x14\n\x03num\x18\x01 \x01(\rB\x07\xfa\x42\x04*\x02(\x01\x12\x0e\n\x06radius\x18\x02 \x01(\x02\x12\x0f\n\x07\x65psilon\x18\x03 \x01(\x02\x1a[\n\x08Response\x12)\n\x07results\x18\x01 \x03(\x0b\x32\x18.payload.Object.Distance\x12$\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x15.payload.Common.Error\"\x81\x02\n\x06Object\x1a<\n\x08\x44istance\x12\x1e\n\x02id\x18\x01 \x01(\x0b\x32\x12.payload.Object.ID\x12\x10\n\x08\x64istance\x18\x02 \x01(\x02\x1a\x19\n\x02ID\x12\x13\n\x02id\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x1a&\n\x03IDs\x12\x1f\n\x03ids\x18\x01 \x03(\x0b\x32\x12.payload.Object.ID\x1a\x42\n\x06Vector\x12\x1e\n\x02id\x18\x01 \x01(\x0b\x32\x12.payload.Object.ID\x12\x18\n\x06vector\x18\x02 \x03(\x01\x42\x08\xfa\x42\x05\x92\x01\x02\x08\x02\x1a\x32\n\x07Vectors\x12\'\n\x07vectors\x18\x01 \x03(\x0b\x32\x16.payload.Object.Vector\"<\n\x08\x43ontroll\x1a\x30\n\x12\x43reateIndexRequest\x12\x1a\n\tpool_size\x18\x01 \x01(\rB\x07\xfa\x42\x04*\x02(\x00\"\xaa\x01\n\x04Info\x1ai\n\x05\x41gent\x12\x13\n\x02ip\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02x\x01\x12\x16\n\x05\x63ount\x18\x02 \x01(\rB\x07\xfa\x42\x04*\x02(\x00\x12\r\n\x05state\x18\x03 \x01(\t\x12$\n\x05\x65rror\x18\x04 \x01(\x0b\x32\x15.payload.Common.Error\x1a\x37\n\x06\x41gents\x12-\n\x06\x41gents\x18\x01 \x03(\x0b\x32\x13.payload.Info.AgentB\x08\xfa\x42\x05\x92\x01\x02\x08\x01\"\x82\x01\n\x06\x43ommon\x1a\x07\n\x05\x45mpty\x1a>\n\x05\x45rror\x12\x15\n\x04\x63ode\x18\x01 \x01(\rB\x07\xfa\x42\x04*\x02(\x00\x12\x0b\n\x03msg\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x03\x1a/\n\x06\x45rrors\x12%\n\x06\x65rrors\x18\x01 \x03(\x0b\x32\x15.payload.Common.ErrorBP\n\x16org.vdaas.vald.payloadB\x0bValdPayloadP\x01Z\'github.com/vdaas/vald/apis/grpc/payloadb\x06proto3')
+  serialized_pb=_b('\n\rpayload.proto\x12\x07payload\x1a\x17validate/validate.proto\"\xf9\x04\n\x06Search\x1aY\n\x07Request\x12&\n\x06vector\x18\x01 \x01(\x0b\x32\x16.payload.Object.Vector\x12&\n\x06\x63onfig\x18\x02 \x01(\x0b\x32\x16.payload.Search.Config\x1a_\n\x0cMultiRequest\x12\'\n\x07vectors\x18\x01 \x03(\x0b\x32\x16.payload.Object.Vector\x12&\n\x06\x63onfig\x18\x02 \x01(\x0b\x32\x16.payload.Search.Config\x1aS\n\tIDRequest\x12\x1e\n\x02id\x18\x01 \x01(\x0b\x32\x12.payload.Object.ID\x12&\n\x06\x63onfig\x18\x02 \x01(\x0b\x32\x16.payload.Search.Config\x1a\x9e\x01\n\x06\x43onfig\x12\x14\n\x03num\x18\x01 \x01(\rB\x07\xfa\x42\x04*\x02(\x01\x12\x0e\n\x06radius\x18\x02 \x01(\x02\x12\x0f\n\x07\x65psilon\x18\x03 \x01(\x02\x12\r\n\x05\x65xact\x18\x04 \x01(\x08\x12\x13\n\x0binclude_ids\x18\x05 \x03(\t\x12\x13\n\x0b\x65xclude_ids\x18\x06 \x03(\t\x12\r\n\x05index\x18\x07 \x01(\t\x12\x15\n\rwith_metadata\x18\x08 \x01(\x08\x1a\x82\x01\n\x08Response\x12)\n\x07results\x18\x01 \x03(\x0b\x32\x18.payload.Object.Distance\x12$\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x15.payload.Common.Error\x12%\n\x06\x65rrors\x18\x03 \x03(\x0b\x32\x15.payload.Common.Error\x1a\x38\n\tResponses\x12+\n\tresponses\x18\x01 \x03(\x0b\x32\x18.payload.Search.Response\"\x99\x03\n\x06Object\x1a\xa7\x01\n\x08\x44istance\x12\x1e\n\x02id\x18\x01 \x01(\x0b\x32\x12.payload.Object.ID\x12\x10\n\x08\x64istance\x18\x02 \x01(\x02\x12\x38\n\x08metadata\x18\x03 \x03(\x0b\x32&.payload.Object.Distance.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a(\n\x02ID\x12\x13\n\x02id\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x12\r\n\x05index\x18\x02 \x01(\t\x1a&\n\x03IDs\x12\x1f\n\x03ids\x18\x01 \x03(\x0b\x32\x12.payload.Object.ID\x1a_\n\x06Vector\x12\x1e\n\x02id\x18\x01 \x01(\x0b\x32\x12.payload.Object.ID\x12\x0e\n\x06vector\x18\x02 \x03(\x01\x12\x16\n\x0e\x66loat32_vector\x18\x03 \x03(\x02\x12\r\n\x05index\x18\x04 \x01(\t\x1a\x32\n\x07Vectors\x12\'\n\x07vectors\x18\x01 \x03(\x0b\x32\x16.payload.Object.Vector\"\xb2\x01\n\x04Meta\x1ax\n\x06Object\x12\x13\n\x02id\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x12,\n\x04\x64\x61ta\x18\x02 \x03(\x0b\x32\x1e.payload.Meta.Object.DataEntry\x1a+\n\tDataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x30\n\x07Objects\x12%\n\x07objects\x18\x01 \x03(\x0b\x32\x14.payload.Meta.Object\"\xfa\x01\n\x06\x42\x61\x63kup\x1a\'\n\x07Request\x12\r\n\x05\x61gent\x18\x01 \x01(\t\x12\r\n\x05index\x18\x02 \x01(\t\x1a\x63\n\x04Info\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05\x61gent\x18\x02 \x01(\t\x12\r\n\x05index\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08\x63hecksum\x18\x05 \x01(\t\x12\x11\n\ttimestamp\x18\x06 \x01(\x03\x1a,\n\x05Infos\x12#\n\x05infos\x18\x01 \x03(\x0b\x32\x14.payload.Backup.Info\x1a\x34\n\x0eRestoreRequest\x12\x13\n\x02id\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x12\r\n\x05\x61gent\x18\x02 \x01(\t\"\x98\x01\n\x07Traffic\x1a;\n\x07Request\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12\x0e\n\x06method\x18\x02 \x01(\t\x12\x0f\n\x07objects\x18\x03 \x01(\x04\x1aP\n\x08Response\x12\x0f\n\x07\x61llowed\x18\x01 \x01(\x08\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x0e\n\x06reason\x18\x03 \x01(\t\x12\x13\n\x0bretry_after\x18\x04 \x01(\x03\"\xca\x01\n\x0bReplication\x1a\xba\x01\n\x06Status\x12\x0f\n\x07running\x18\x01 \x01(\x08\x12\x12\n\nstarted_at\x18\x02 \x01(\x03\x12\x13\n\x0b\x66inished_at\x18\x03 \x01(\x03\x12\x0e\n\x06\x61gents\x18\x04 \x01(\r\x12\x0f\n\x07objects\x18\x05 \x01(\x04\x12\x18\n\x10under_replicated\x18\x06 \x01(\x04\x12\x0e\n\x06healed\x18\x07 \x01(\x04\x12\x0e\n\x06\x66\x61iled\x18\x08 \x01(\x04\x12\x0c\n\x04runs\x18\t \x01(\x04\x12\r\n\x05\x65rror\x18\n \x01(\t\"\xc5\x02\n\x08\x43ontroll\x1a?\n\x12\x43reateIndexRequest\x12\x1a\n\tpool_size\x18\x01 \x01(\rB\x07\xfa\x42\x04*\x02(\x00\x12\r\n\x05index\x18\x02 \x01(\t\x1a\x1d\n\x0cIndexRequest\x12\r\n\x05index\x18\x01 \x01(\t\x1a\xa4\x01\n\rImportRequest\x12\x15\n\x04path\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x12\x0e\n\x06\x66ormat\x18\x02 \x01(\t\x12\x11\n\tid_column\x18\x03 \x01(\r\x12\x11\n\tid_prefix\x18\x04 \x01(\t\x12\x0e\n\x06header\x18\x05 \x01(\x08\x12\x14\n\x0chdf5_dataset\x18\x06 \x01(\t\x12\x11\n\tpool_size\x18\x07 \x01(\r\x12\r\n\x05index\x18\x08 \x01(\t\x1a\x32\n\x0eImportProgress\x12\x10\n\x08inserted\x18\x01 \x01(\x04\x12\x0e\n\x06\x66\x61iled\x18\x02 \x01(\x04\"\xc2\x04\n\x04Info\x1a\xd6\x01\n\x05Index\x12\x0e\n\x06stored\x18\x01 \x01(\x04\x12\x0f\n\x07indexed\x18\x02 \x01(\x04\x12\x13\n\x0buncommitted\x18\x03 \x01(\x04\x12\x0f\n\x07removed\x18\x04 \x01(\x04\x12\x11\n\tdimension\x18\x05 \x01(\r\x12\x13\n\x0bobject_type\x18\x06 \x01(\t\x12\x15\n\rdistance_type\x18\x07 \x01(\t\x12\x1a\n\x12\x63reation_edge_size\x18\x08 \x01(\r\x12\x18\n\x10search_edge_size\x18\t \x01(\r\x12\x11\n\tdisk_size\x18\n \x01(\x03\x1ai\n\x05\x41gent\x12\x13\n\x02ip\x18\x01 \x01(\tB\x07\xfa\x42\x04r\x02x\x01\x12\x16\n\x05\x63ount\x18\x02 \x01(\rB\x07\xfa\x42\x04*\x02(\x00\x12\r\n\x05state\x18\x03 \x01(\t\x12$\n\x05\x65rror\x18\x04 \x01(\x0b\x32\x15.payload.Common.Error\x1a\x37\n\x06\x41gents\x12-\n\x06\x41gents\x18\x01 \x03(\x0b\x32\x13.payload.Info.AgentB\x08\xfa\x42\x05\x92\x01\x02\x08\x01\x1a\xbc\x01\n\nAgentEvent\x12+\n\x04type\x18\x01 \x01(\x0e\x32\x1d.payload.Info.AgentEvent.Type\x12\"\n\x05\x61gent\x18\x02 \x01(\x0b\x32\x13.payload.Info.Agent\x12&\n\x08snapshot\x18\x03 \x01(\x0b\x32\x14.payload.Info.Agents\"5\n\x04Type\x12\x0c\n\x08SNAPSHOT\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06UPDATE\x10\x02\x12\n\n\x06REMOVE\x10\x03\"0\n\x08Snapshot\x1a$\n\x05\x43hunk\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\r\n\x05index\x18\x02 \x01(\t\"\x8e\x01\n\x06\x43ommon\x1a\x07\n\x05\x45mpty\x1aJ\n\x05\x45rror\x12\x15\n\x04\x63ode\x18\x01 \x01(\rB\x07\xfa\x42\x04*\x02(\x00\x12\x0b\n\x03msg\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x03\x12\n\n\x02id\x18\x04 \x01(\t\x1a/\n\x06\x45rrors\x12%\n\x06\x65rrors\x18\x01 \x03(\x0b\x32\x15.payload.Common.ErrorBP\n\x16org.vdaas.vald.payloadB\x0bValdPayloadP\x01Z\'github.com/vdaas/vald/apis/grpc/payloadb\x06proto3')
   ,
   dependencies=[validate_dot_validate__pb2.DESCRIPTOR,])
 
 
 
+_INFO_AGENTEVENT_TYPE = _descriptor.EnumDescriptor(
+  name='Type',
+  full_name='payload.Info.AgentEvent.Type',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='SNAPSHOT', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ADD', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='UPDATE', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='REMOVE', index=3, number=3,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2747,
+  serialized_end=2800,
+)
+_sym_db.RegisterEnumDescriptor(_INFO_AGENTEVENT_TYPE)
+
 
 _SEARCH_REQUEST = _descriptor.Descriptor(
   name='Request',
@@ -65,6 +95,43 @@ _SEARCH_REQUEST = _descriptor.Descriptor(
   serialized_end=151,
 )
 
+_SEARCH_MULTIREQUEST = _descriptor.Descriptor(
+  name='MultiRequest',
+  full_name='payload.Search.MultiRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vectors', full_name='payload.Search.MultiRequest.vectors', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='config', full_name='payload.Search.MultiRequest.config', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=153,
+  serialized_end=248,
+)
+
 _SEARCH_IDREQUEST = _descriptor.Descriptor(
   name='IDRequest',
   full_name='payload.Search.IDRequest',
@@ -98,8 +165,8 @@ _SEARCH_IDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=153,
-  serialized_end=236,
+  serialized_start=250,
+  serialized_end=333,
 )
 
 _SEARCH_CONFIG = _descriptor.Descriptor(
@@ -130,6 +197,41 @@ _SEARCH_CONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='exact', full_name='payload.Search.Config.exact', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='include_ids', full_name='payload.Search.Config.include_ids', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='exclude_ids', full_name='payload.Search.Config.exclude_ids', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Search.Config.index', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='with_metadata', full_name='payload.Search.Config.with_metadata', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -142,8 +244,8 @@ _SEARCH_CONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=238,
-  serialized_end=301,
+  serialized_start=336,
+  serialized_end=494,
 )
 
 _SEARCH_RESPONSE = _descriptor.Descriptor(
@@ -167,6 +269,43 @@ _SEARCH_RESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='errors', full_name='payload.Search.Response.errors', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=497,
+  serialized_end=627,
+)
+
+_SEARCH_RESPONSES = _descriptor.Descriptor(
+  name='Responses',
+  full_name='payload.Search.Responses',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='responses', full_name='payload.Search.Responses.responses', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -179,8 +318,8 @@ _SEARCH_RESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=303,
-  serialized_end=394,
+  serialized_start=629,
+  serialized_end=685,
 )
 
 _SEARCH = _descriptor.Descriptor(
@@ -193,7 +332,7 @@ _SEARCH = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_SEARCH_REQUEST, _SEARCH_IDREQUEST, _SEARCH_CONFIG, _SEARCH_RESPONSE, ],
+  nested_types=[_SEARCH_REQUEST, _SEARCH_MULTIREQUEST, _SEARCH_IDREQUEST, _SEARCH_CONFIG, _SEARCH_RESPONSE, _SEARCH_RESPONSES, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -203,10 +342,47 @@ _SEARCH = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=52,
-  serialized_end=394,
+  serialized_end=685,
 )
 
 
+_OBJECT_DISTANCE_METADATAENTRY = _descriptor.Descriptor(
+  name='MetadataEntry',
+  full_name='payload.Object.Distance.MetadataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='payload.Object.Distance.MetadataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='payload.Object.Distance.MetadataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=819,
+  serialized_end=866,
+)
+
 _OBJECT_DISTANCE = _descriptor.Descriptor(
   name='Distance',
   full_name='payload.Object.Distance',
@@ -228,10 +404,17 @@ _OBJECT_DISTANCE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='payload.Object.Distance.metadata', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_OBJECT_DISTANCE_METADATAENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -240,8 +423,8 @@ _OBJECT_DISTANCE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=407,
-  serialized_end=467,
+  serialized_start=699,
+  serialized_end=866,
 )
 
 _OBJECT_ID = _descriptor.Descriptor(
@@ -258,6 +441,13 @@ _OBJECT_ID = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=_b('\372B\004r\002\020\001'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Object.ID.index', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -270,8 +460,8 @@ _OBJECT_ID = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=469,
-  serialized_end=494,
+  serialized_start=868,
+  serialized_end=908,
 )
 
 _OBJECT_IDS = _descriptor.Descriptor(
@@ -300,8 +490,8 @@ _OBJECT_IDS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=496,
-  serialized_end=534,
+  serialized_start=910,
+  serialized_end=948,
 )
 
 _OBJECT_VECTOR = _descriptor.Descriptor(
@@ -324,7 +514,21 @@ _OBJECT_VECTOR = _descriptor.Descriptor(
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
-      serialized_options=_b('\372B\005\222\001\002\010\002'), file=DESCRIPTOR),
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='float32_vector', full_name='payload.Object.Vector.float32_vector', index=2,
+      number=3, type=2, cpp_type=6, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Object.Vector.index', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -337,8 +541,8 @@ _OBJECT_VECTOR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=536,
-  serialized_end=602,
+  serialized_start=950,
+  serialized_end=1045,
 )
 
 _OBJECT_VECTORS = _descriptor.Descriptor(
@@ -367,8 +571,8 @@ _OBJECT_VECTORS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=604,
-  serialized_end=654,
+  serialized_start=1047,
+  serialized_end=1097,
 )
 
 _OBJECT = _descriptor.Descriptor(
@@ -390,104 +594,73 @@ _OBJECT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=397,
-  serialized_end=654,
+  serialized_start=688,
+  serialized_end=1097,
 )
 
 
-_CONTROLL_CREATEINDEXREQUEST = _descriptor.Descriptor(
-  name='CreateIndexRequest',
-  full_name='payload.Controll.CreateIndexRequest',
+_META_OBJECT_DATAENTRY = _descriptor.Descriptor(
+  name='DataEntry',
+  full_name='payload.Meta.Object.DataEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pool_size', full_name='payload.Controll.CreateIndexRequest.pool_size', index=0,
-      number=1, type=13, cpp_type=3, label=1,
-      has_default_value=False, default_value=0,
+      name='key', full_name='payload.Meta.Object.DataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
-      serialized_options=_b('\372B\004*\002(\000'), file=DESCRIPTOR),
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='payload.Meta.Object.DataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=668,
-  serialized_end=716,
-)
-
-_CONTROLL = _descriptor.Descriptor(
-  name='Controll',
-  full_name='payload.Controll',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
-  ],
-  extensions=[
-  ],
-  nested_types=[_CONTROLL_CREATEINDEXREQUEST, ],
-  enum_types=[
-  ],
-  serialized_options=None,
+  serialized_options=_b('8\001'),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=656,
-  serialized_end=716,
+  serialized_start=1185,
+  serialized_end=1228,
 )
 
-
-_INFO_AGENT = _descriptor.Descriptor(
-  name='Agent',
-  full_name='payload.Info.Agent',
+_META_OBJECT = _descriptor.Descriptor(
+  name='Object',
+  full_name='payload.Meta.Object',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='ip', full_name='payload.Info.Agent.ip', index=0,
+      name='id', full_name='payload.Meta.Object.id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
-      serialized_options=_b('\372B\004r\002x\001'), file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='count', full_name='payload.Info.Agent.count', index=1,
-      number=2, type=13, cpp_type=3, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=_b('\372B\004*\002(\000'), file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='state', full_name='payload.Info.Agent.state', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
+      serialized_options=_b('\372B\004r\002\020\001'), file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='error', full_name='payload.Info.Agent.error', index=3,
-      number=4, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='data', full_name='payload.Meta.Object.data', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_META_OBJECT_DATAENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -496,24 +669,24 @@ _INFO_AGENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=727,
-  serialized_end=832,
+  serialized_start=1108,
+  serialized_end=1228,
 )
 
-_INFO_AGENTS = _descriptor.Descriptor(
-  name='Agents',
-  full_name='payload.Info.Agents',
+_META_OBJECTS = _descriptor.Descriptor(
+  name='Objects',
+  full_name='payload.Meta.Objects',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='Agents', full_name='payload.Info.Agents.Agents', index=0,
+      name='objects', full_name='payload.Meta.Objects.objects', index=0,
       number=1, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
-      serialized_options=_b('\372B\005\222\001\002\010\001'), file=DESCRIPTOR),
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -526,13 +699,13 @@ _INFO_AGENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=834,
-  serialized_end=889,
+  serialized_start=1230,
+  serialized_end=1278,
 )
 
-_INFO = _descriptor.Descriptor(
-  name='Info',
-  full_name='payload.Info',
+_META = _descriptor.Descriptor(
+  name='Meta',
+  full_name='payload.Meta',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -540,7 +713,7 @@ _INFO = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_INFO_AGENT, _INFO_AGENTS, ],
+  nested_types=[_META_OBJECT, _META_OBJECTS, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -549,18 +722,32 @@ _INFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=719,
-  serialized_end=889,
+  serialized_start=1100,
+  serialized_end=1278,
 )
 
 
-_COMMON_EMPTY = _descriptor.Descriptor(
-  name='Empty',
-  full_name='payload.Common.Empty',
+_BACKUP_REQUEST = _descriptor.Descriptor(
+  name='Request',
+  full_name='payload.Backup.Request',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='agent', full_name='payload.Backup.Request.agent', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Backup.Request.index', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -573,40 +760,973 @@ _COMMON_EMPTY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=902,
-  serialized_end=909,
+  serialized_start=1291,
+  serialized_end=1330,
 )
 
-_COMMON_ERROR = _descriptor.Descriptor(
-  name='Error',
-  full_name='payload.Common.Error',
+_BACKUP_INFO = _descriptor.Descriptor(
+  name='Info',
+  full_name='payload.Backup.Info',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='code', full_name='payload.Common.Error.code', index=0,
-      number=1, type=13, cpp_type=3, label=1,
-      has_default_value=False, default_value=0,
+      name='id', full_name='payload.Backup.Info.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
-      serialized_options=_b('\372B\004*\002(\000'), file=DESCRIPTOR),
+      serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='msg', full_name='payload.Common.Error.msg', index=1,
+      name='agent', full_name='payload.Backup.Info.agent', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='timestamp', full_name='payload.Common.Error.timestamp', index=2,
-      number=3, type=3, cpp_type=2, label=1,
+      name='index', full_name='payload.Backup.Info.index', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size', full_name='payload.Backup.Info.size', index=3,
+      number=4, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-  ],
-  extensions=[
+    _descriptor.FieldDescriptor(
+      name='checksum', full_name='payload.Backup.Info.checksum', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='payload.Backup.Info.timestamp', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1332,
+  serialized_end=1431,
+)
+
+_BACKUP_INFOS = _descriptor.Descriptor(
+  name='Infos',
+  full_name='payload.Backup.Infos',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='infos', full_name='payload.Backup.Infos.infos', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1433,
+  serialized_end=1477,
+)
+
+_BACKUP_RESTOREREQUEST = _descriptor.Descriptor(
+  name='RestoreRequest',
+  full_name='payload.Backup.RestoreRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='payload.Backup.RestoreRequest.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\004r\002\020\001'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='agent', full_name='payload.Backup.RestoreRequest.agent', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1479,
+  serialized_end=1531,
+)
+
+_BACKUP = _descriptor.Descriptor(
+  name='Backup',
+  full_name='payload.Backup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[_BACKUP_REQUEST, _BACKUP_INFO, _BACKUP_INFOS, _BACKUP_RESTOREREQUEST, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1281,
+  serialized_end=1531,
+)
+
+
+_TRAFFIC_REQUEST = _descriptor.Descriptor(
+  name='Request',
+  full_name='payload.Traffic.Request',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='api_key', full_name='payload.Traffic.Request.api_key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='method', full_name='payload.Traffic.Request.method', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='objects', full_name='payload.Traffic.Request.objects', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1545,
+  serialized_end=1604,
+)
+
+_TRAFFIC_RESPONSE = _descriptor.Descriptor(
+  name='Response',
+  full_name='payload.Traffic.Response',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='allowed', full_name='payload.Traffic.Response.allowed', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tenant', full_name='payload.Traffic.Response.tenant', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='payload.Traffic.Response.reason', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='retry_after', full_name='payload.Traffic.Response.retry_after', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1606,
+  serialized_end=1686,
+)
+
+_TRAFFIC = _descriptor.Descriptor(
+  name='Traffic',
+  full_name='payload.Traffic',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[_TRAFFIC_REQUEST, _TRAFFIC_RESPONSE, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1534,
+  serialized_end=1686,
+)
+
+
+_REPLICATION_STATUS = _descriptor.Descriptor(
+  name='Status',
+  full_name='payload.Replication.Status',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='running', full_name='payload.Replication.Status.running', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='started_at', full_name='payload.Replication.Status.started_at', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='finished_at', full_name='payload.Replication.Status.finished_at', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='agents', full_name='payload.Replication.Status.agents', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='objects', full_name='payload.Replication.Status.objects', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='under_replicated', full_name='payload.Replication.Status.under_replicated', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='healed', full_name='payload.Replication.Status.healed', index=6,
+      number=7, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='failed', full_name='payload.Replication.Status.failed', index=7,
+      number=8, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='runs', full_name='payload.Replication.Status.runs', index=8,
+      number=9, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='payload.Replication.Status.error', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1705,
+  serialized_end=1891,
+)
+
+_REPLICATION = _descriptor.Descriptor(
+  name='Replication',
+  full_name='payload.Replication',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[_REPLICATION_STATUS, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1689,
+  serialized_end=1891,
+)
+
+
+_CONTROLL_CREATEINDEXREQUEST = _descriptor.Descriptor(
+  name='CreateIndexRequest',
+  full_name='payload.Controll.CreateIndexRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pool_size', full_name='payload.Controll.CreateIndexRequest.pool_size', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\004*\002(\000'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Controll.CreateIndexRequest.index', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1906,
+  serialized_end=1969,
+)
+
+_CONTROLL_INDEXREQUEST = _descriptor.Descriptor(
+  name='IndexRequest',
+  full_name='payload.Controll.IndexRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Controll.IndexRequest.index', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1971,
+  serialized_end=2000,
+)
+
+_CONTROLL_IMPORTREQUEST = _descriptor.Descriptor(
+  name='ImportRequest',
+  full_name='payload.Controll.ImportRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='path', full_name='payload.Controll.ImportRequest.path', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\004r\002\020\001'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='format', full_name='payload.Controll.ImportRequest.format', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id_column', full_name='payload.Controll.ImportRequest.id_column', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id_prefix', full_name='payload.Controll.ImportRequest.id_prefix', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='header', full_name='payload.Controll.ImportRequest.header', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hdf5_dataset', full_name='payload.Controll.ImportRequest.hdf5_dataset', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pool_size', full_name='payload.Controll.ImportRequest.pool_size', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Controll.ImportRequest.index', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2003,
+  serialized_end=2167,
+)
+
+_CONTROLL_IMPORTPROGRESS = _descriptor.Descriptor(
+  name='ImportProgress',
+  full_name='payload.Controll.ImportProgress',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='inserted', full_name='payload.Controll.ImportProgress.inserted', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='failed', full_name='payload.Controll.ImportProgress.failed', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2169,
+  serialized_end=2219,
+)
+
+_CONTROLL = _descriptor.Descriptor(
+  name='Controll',
+  full_name='payload.Controll',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[_CONTROLL_CREATEINDEXREQUEST, _CONTROLL_INDEXREQUEST, _CONTROLL_IMPORTREQUEST, _CONTROLL_IMPORTPROGRESS, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1894,
+  serialized_end=2219,
+)
+
+
+_INFO_INDEX = _descriptor.Descriptor(
+  name='Index',
+  full_name='payload.Info.Index',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='stored', full_name='payload.Info.Index.stored', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='indexed', full_name='payload.Info.Index.indexed', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uncommitted', full_name='payload.Info.Index.uncommitted', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='removed', full_name='payload.Info.Index.removed', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dimension', full_name='payload.Info.Index.dimension', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='object_type', full_name='payload.Info.Index.object_type', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='distance_type', full_name='payload.Info.Index.distance_type', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='creation_edge_size', full_name='payload.Info.Index.creation_edge_size', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='search_edge_size', full_name='payload.Info.Index.search_edge_size', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='disk_size', full_name='payload.Info.Index.disk_size', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2231,
+  serialized_end=2445,
+)
+
+_INFO_AGENT = _descriptor.Descriptor(
+  name='Agent',
+  full_name='payload.Info.Agent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ip', full_name='payload.Info.Agent.ip', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\004r\002x\001'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='count', full_name='payload.Info.Agent.count', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\004*\002(\000'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='payload.Info.Agent.state', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='payload.Info.Agent.error', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2447,
+  serialized_end=2552,
+)
+
+_INFO_AGENTS = _descriptor.Descriptor(
+  name='Agents',
+  full_name='payload.Info.Agents',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='Agents', full_name='payload.Info.Agents.Agents', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\005\222\001\002\010\001'), file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2554,
+  serialized_end=2609,
+)
+
+_INFO_AGENTEVENT = _descriptor.Descriptor(
+  name='AgentEvent',
+  full_name='payload.Info.AgentEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='payload.Info.AgentEvent.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='agent', full_name='payload.Info.AgentEvent.agent', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='snapshot', full_name='payload.Info.AgentEvent.snapshot', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _INFO_AGENTEVENT_TYPE,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2612,
+  serialized_end=2800,
+)
+
+_INFO = _descriptor.Descriptor(
+  name='Info',
+  full_name='payload.Info',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[_INFO_INDEX, _INFO_AGENT, _INFO_AGENTS, _INFO_AGENTEVENT, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2222,
+  serialized_end=2800,
+)
+
+
+_SNAPSHOT_CHUNK = _descriptor.Descriptor(
+  name='Chunk',
+  full_name='payload.Snapshot.Chunk',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='data', full_name='payload.Snapshot.Chunk.data', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='payload.Snapshot.Chunk.index', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2814,
+  serialized_end=2850,
+)
+
+_SNAPSHOT = _descriptor.Descriptor(
+  name='Snapshot',
+  full_name='payload.Snapshot',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[_SNAPSHOT_CHUNK, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2802,
+  serialized_end=2850,
+)
+
+
+_COMMON_EMPTY = _descriptor.Descriptor(
+  name='Empty',
+  full_name='payload.Common.Empty',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2863,
+  serialized_end=2870,
+)
+
+_COMMON_ERROR = _descriptor.Descriptor(
+  name='Error',
+  full_name='payload.Common.Error',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='code', full_name='payload.Common.Error.code', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=_b('\372B\004*\002(\000'), file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='msg', full_name='payload.Common.Error.msg', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='payload.Common.Error.timestamp', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='payload.Common.Error.id', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
   ],
   nested_types=[],
   enum_types=[
@@ -617,8 +1737,8 @@ _COMMON_ERROR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=911,
-  serialized_end=973,
+  serialized_start=2872,
+  serialized_end=2946,
 )
 
 _COMMON_ERRORS = _descriptor.Descriptor(
@@ -647,8 +1767,8 @@ _COMMON_ERRORS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=975,
-  serialized_end=1022,
+  serialized_start=2948,
+  serialized_end=2995,
 )
 
 _COMMON = _descriptor.Descriptor(
@@ -670,21 +1790,29 @@ _COMMON = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=892,
-  serialized_end=1022,
+  serialized_start=2853,
+  serialized_end=2995,
 )
 
 _SEARCH_REQUEST.fields_by_name['vector'].message_type = _OBJECT_VECTOR
 _SEARCH_REQUEST.fields_by_name['config'].message_type = _SEARCH_CONFIG
 _SEARCH_REQUEST.containing_type = _SEARCH
+_SEARCH_MULTIREQUEST.fields_by_name['vectors'].message_type = _OBJECT_VECTOR
+_SEARCH_MULTIREQUEST.fields_by_name['config'].message_type = _SEARCH_CONFIG
+_SEARCH_MULTIREQUEST.containing_type = _SEARCH
 _SEARCH_IDREQUEST.fields_by_name['id'].message_type = _OBJECT_ID
 _SEARCH_IDREQUEST.fields_by_name['config'].message_type = _SEARCH_CONFIG
 _SEARCH_IDREQUEST.containing_type = _SEARCH
 _SEARCH_CONFIG.containing_type = _SEARCH
 _SEARCH_RESPONSE.fields_by_name['results'].message_type = _OBJECT_DISTANCE
 _SEARCH_RESPONSE.fields_by_name['error'].message_type = _COMMON_ERROR
+_SEARCH_RESPONSE.fields_by_name['errors'].message_type = _COMMON_ERROR
 _SEARCH_RESPONSE.containing_type = _SEARCH
+_SEARCH_RESPONSES.fields_by_name['responses'].message_type = _SEARCH_RESPONSE
+_SEARCH_RESPONSES.containing_type = _SEARCH
+_OBJECT_DISTANCE_METADATAENTRY.containing_type = _OBJECT_DISTANCE
 _OBJECT_DISTANCE.fields_by_name['id'].message_type = _OBJECT_ID
+_OBJECT_DISTANCE.fields_by_name['metadata'].message_type = _OBJECT_DISTANCE_METADATAENTRY
 _OBJECT_DISTANCE.containing_type = _OBJECT
 _OBJECT_ID.containing_type = _OBJECT
 _OBJECT_IDS.fields_by_name['ids'].message_type = _OBJECT_ID
@@ -693,19 +1821,47 @@ _OBJECT_VECTOR.fields_by_name['id'].message_type = _OBJECT_ID
 _OBJECT_VECTOR.containing_type = _OBJECT
 _OBJECT_VECTORS.fields_by_name['vectors'].message_type = _OBJECT_VECTOR
 _OBJECT_VECTORS.containing_type = _OBJECT
+_META_OBJECT_DATAENTRY.containing_type = _META_OBJECT
+_META_OBJECT.fields_by_name['data'].message_type = _META_OBJECT_DATAENTRY
+_META_OBJECT.containing_type = _META
+_META_OBJECTS.fields_by_name['objects'].message_type = _META_OBJECT
+_META_OBJECTS.containing_type = _META
+_BACKUP_REQUEST.containing_type = _BACKUP
+_BACKUP_INFO.containing_type = _BACKUP
+_BACKUP_INFOS.fields_by_name['infos'].message_type = _BACKUP_INFO
+_BACKUP_INFOS.containing_type = _BACKUP
+_BACKUP_RESTOREREQUEST.containing_type = _BACKUP
+_TRAFFIC_REQUEST.containing_type = _TRAFFIC
+_TRAFFIC_RESPONSE.containing_type = _TRAFFIC
+_REPLICATION_STATUS.containing_type = _REPLICATION
 _CONTROLL_CREATEINDEXREQUEST.containing_type = _CONTROLL
+_CONTROLL_INDEXREQUEST.containing_type = _CONTROLL
+_CONTROLL_IMPORTREQUEST.containing_type = _CONTROLL
+_CONTROLL_IMPORTPROGRESS.containing_type = _CONTROLL
+_INFO_INDEX.containing_type = _INFO
 _INFO_AGENT.fields_by_name['error'].message_type = _COMMON_ERROR
 _INFO_AGENT.containing_type = _INFO
 _INFO_AGENTS.fields_by_name['Agents'].message_type = _INFO_AGENT
 _INFO_AGENTS.containing_type = _INFO
+_INFO_AGENTEVENT.fields_by_name['type'].enum_type = _INFO_AGENTEVENT_TYPE
+_INFO_AGENTEVENT.fields_by_name['agent'].message_type = _INFO_AGENT
+_INFO_AGENTEVENT.fields_by_name['snapshot'].message_type = _INFO_AGENTS
+_INFO_AGENTEVENT.containing_type = _INFO
+_INFO_AGENTEVENT_TYPE.containing_type = _INFO_AGENTEVENT
+_SNAPSHOT_CHUNK.containing_type = _SNAPSHOT
 _COMMON_EMPTY.containing_type = _COMMON
 _COMMON_ERROR.containing_type = _COMMON
 _COMMON_ERRORS.fields_by_name['errors'].message_type = _COMMON_ERROR
 _COMMON_ERRORS.containing_type = _COMMON
 DESCRIPTOR.message_types_by_name['Search'] = _SEARCH
 DESCRIPTOR.message_types_by_name['Object'] = _OBJECT
+DESCRIPTOR.message_types_by_name['Meta'] = _META
+DESCRIPTOR.message_types_by_name['Backup'] = _BACKUP
+DESCRIPTOR.message_types_by_name['Traffic'] = _TRAFFIC
+DESCRIPTOR.message_types_by_name['Replication'] = _REPLICATION
 DESCRIPTOR.message_types_by_name['Controll'] = _CONTROLL
 DESCRIPTOR.message_types_by_name['Info'] = _INFO
+DESCRIPTOR.message_types_by_name['Snapshot'] = _SNAPSHOT
 DESCRIPTOR.message_types_by_name['Common'] = _COMMON
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
@@ -718,6 +1874,13 @@ Search = _reflection.GeneratedProtocolMessageType('Search', (_message.Message,),
     })
   ,
 
+  'MultiRequest' : _reflection.GeneratedProtocolMessageType('MultiRequest', (_message.Message,), {
+    'DESCRIPTOR' : _SEARCH_MULTIREQUEST,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Search.MultiRequest)
+    })
+  ,
+
   'IDRequest' : _reflection.GeneratedProtocolMessageType('IDRequest', (_message.Message,), {
     'DESCRIPTOR' : _SEARCH_IDREQUEST,
     '__module__' : 'payload_pb2'
@@ -738,19 +1901,35 @@ Search = _reflection.GeneratedProtocolMessageType('Search', (_message.Message,),
     # @@protoc_insertion_point(class_scope:payload.Search.Response)
     })
   ,
+
+  'Responses' : _reflection.GeneratedProtocolMessageType('Responses', (_message.Message,), {
+    'DESCRIPTOR' : _SEARCH_RESPONSES,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Search.Responses)
+    })
+  ,
   'DESCRIPTOR' : _SEARCH,
   '__module__' : 'payload_pb2'
   # @@protoc_insertion_point(class_scope:payload.Search)
   })
 _sym_db.RegisterMessage(Search)
 _sym_db.RegisterMessage(Search.Request)
+_sym_db.RegisterMessage(Search.MultiRequest)
 _sym_db.RegisterMessage(Search.IDRequest)
 _sym_db.RegisterMessage(Search.Config)
 _sym_db.RegisterMessage(Search.Response)
+_sym_db.RegisterMessage(Search.Responses)
 
 Object = _reflection.GeneratedProtocolMessageType('Object', (_message.Message,), {
 
   'Distance' : _reflection.GeneratedProtocolMessageType('Distance', (_message.Message,), {
+
+    'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+      'DESCRIPTOR' : _OBJECT_DISTANCE_METADATAENTRY,
+      '__module__' : 'payload_pb2'
+      # @@protoc_insertion_point(class_scope:payload.Object.Distance.MetadataEntry)
+      })
+    ,
     'DESCRIPTOR' : _OBJECT_DISTANCE,
     '__module__' : 'payload_pb2'
     # @@protoc_insertion_point(class_scope:payload.Object.Distance)
@@ -790,11 +1969,120 @@ Object = _reflection.GeneratedProtocolMessageType('Object', (_message.Message,),
   })
 _sym_db.RegisterMessage(Object)
 _sym_db.RegisterMessage(Object.Distance)
+_sym_db.RegisterMessage(Object.Distance.MetadataEntry)
 _sym_db.RegisterMessage(Object.ID)
 _sym_db.RegisterMessage(Object.IDs)
 _sym_db.RegisterMessage(Object.Vector)
 _sym_db.RegisterMessage(Object.Vectors)
 
+Meta = _reflection.GeneratedProtocolMessageType('Meta', (_message.Message,), {
+
+  'Object' : _reflection.GeneratedProtocolMessageType('Object', (_message.Message,), {
+
+    'DataEntry' : _reflection.GeneratedProtocolMessageType('DataEntry', (_message.Message,), {
+      'DESCRIPTOR' : _META_OBJECT_DATAENTRY,
+      '__module__' : 'payload_pb2'
+      # @@protoc_insertion_point(class_scope:payload.Meta.Object.DataEntry)
+      })
+    ,
+    'DESCRIPTOR' : _META_OBJECT,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Meta.Object)
+    })
+  ,
+
+  'Objects' : _reflection.GeneratedProtocolMessageType('Objects', (_message.Message,), {
+    'DESCRIPTOR' : _META_OBJECTS,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Meta.Objects)
+    })
+  ,
+  'DESCRIPTOR' : _META,
+  '__module__' : 'payload_pb2'
+  # @@protoc_insertion_point(class_scope:payload.Meta)
+  })
+_sym_db.RegisterMessage(Meta)
+_sym_db.RegisterMessage(Meta.Object)
+_sym_db.RegisterMessage(Meta.Object.DataEntry)
+_sym_db.RegisterMessage(Meta.Objects)
+
+Backup = _reflection.GeneratedProtocolMessageType('Backup', (_message.Message,), {
+
+  'Request' : _reflection.GeneratedProtocolMessageType('Request', (_message.Message,), {
+    'DESCRIPTOR' : _BACKUP_REQUEST,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Backup.Request)
+    })
+  ,
+
+  'Info' : _reflection.GeneratedProtocolMessageType('Info', (_message.Message,), {
+    'DESCRIPTOR' : _BACKUP_INFO,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Backup.Info)
+    })
+  ,
+
+  'Infos' : _reflection.GeneratedProtocolMessageType('Infos', (_message.Message,), {
+    'DESCRIPTOR' : _BACKUP_INFOS,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Backup.Infos)
+    })
+  ,
+
+  'RestoreRequest' : _reflection.GeneratedProtocolMessageType('RestoreRequest', (_message.Message,), {
+    'DESCRIPTOR' : _BACKUP_RESTOREREQUEST,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Backup.RestoreRequest)
+    })
+  ,
+  'DESCRIPTOR' : _BACKUP,
+  '__module__' : 'payload_pb2'
+  # @@protoc_insertion_point(class_scope:payload.Backup)
+  })
+_sym_db.RegisterMessage(Backup)
+_sym_db.RegisterMessage(Backup.Request)
+_sym_db.RegisterMessage(Backup.Info)
+_sym_db.RegisterMessage(Backup.Infos)
+_sym_db.RegisterMessage(Backup.RestoreRequest)
+
+Traffic = _reflection.GeneratedProtocolMessageType('Traffic', (_message.Message,), {
+
+  'Request' : _reflection.GeneratedProtocolMessageType('Request', (_message.Message,), {
+    'DESCRIPTOR' : _TRAFFIC_REQUEST,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Traffic.Request)
+    })
+  ,
+
+  'Response' : _reflection.GeneratedProtocolMessageType('Response', (_message.Message,), {
+    'DESCRIPTOR' : _TRAFFIC_RESPONSE,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Traffic.Response)
+    })
+  ,
+  'DESCRIPTOR' : _TRAFFIC,
+  '__module__' : 'payload_pb2'
+  # @@protoc_insertion_point(class_scope:payload.Traffic)
+  })
+_sym_db.RegisterMessage(Traffic)
+_sym_db.RegisterMessage(Traffic.Request)
+_sym_db.RegisterMessage(Traffic.Response)
+
+Replication = _reflection.GeneratedProtocolMessageType('Replication', (_message.Message,), {
+
+  'Status' : _reflection.GeneratedProtocolMessageType('Status', (_message.Message,), {
+    'DESCRIPTOR' : _REPLICATION_STATUS,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Replication.Status)
+    })
+  ,
+  'DESCRIPTOR' : _REPLICATION,
+  '__module__' : 'payload_pb2'
+  # @@protoc_insertion_point(class_scope:payload.Replication)
+  })
+_sym_db.RegisterMessage(Replication)
+_sym_db.RegisterMessage(Replication.Status)
+
 Controll = _reflection.GeneratedProtocolMessageType('Controll', (_message.Message,), {
 
   'CreateIndexRequest' : _reflection.GeneratedProtocolMessageType('CreateIndexRequest', (_message.Message,), {
@@ -803,15 +2091,46 @@ Controll = _reflection.GeneratedProtocolMessageType('Controll', (_message.Messag
     # @@protoc_insertion_point(class_scope:payload.Controll.CreateIndexRequest)
     })
   ,
+
+  'IndexRequest' : _reflection.GeneratedProtocolMessageType('IndexRequest', (_message.Message,), {
+    'DESCRIPTOR' : _CONTROLL_INDEXREQUEST,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Controll.IndexRequest)
+    })
+  ,
+
+  'ImportRequest' : _reflection.GeneratedProtocolMessageType('ImportRequest', (_message.Message,), {
+    'DESCRIPTOR' : _CONTROLL_IMPORTREQUEST,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Controll.ImportRequest)
+    })
+  ,
+
+  'ImportProgress' : _reflection.GeneratedProtocolMessageType('ImportProgress', (_message.Message,), {
+    'DESCRIPTOR' : _CONTROLL_IMPORTPROGRESS,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Controll.ImportProgress)
+    })
+  ,
   'DESCRIPTOR' : _CONTROLL,
   '__module__' : 'payload_pb2'
   # @@protoc_insertion_point(class_scope:payload.Controll)
   })
 _sym_db.RegisterMessage(Controll)
 _sym_db.RegisterMessage(Controll.CreateIndexRequest)
+_sym_db.RegisterMessage(Controll.IndexRequest)
+_sym_db.RegisterMessage(Controll.ImportRequest)
+_sym_db.RegisterMessage(Controll.ImportProgress)
 
 Info = _reflection.GeneratedProtocolMessageType('Info', (_message.Message,), {
 
+  'Index' : _reflection.GeneratedProtocolMessageType('Index', (_message.Message,), {
+    'DESCRIPTOR' : _INFO_INDEX,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Info.Index)
+    })
+  ,
+
   'Agent' : _reflection.GeneratedProtocolMessageType('Agent', (_message.Message,), {
     'DESCRIPTOR' : _INFO_AGENT,
     '__module__' : 'payload_pb2'
@@ -825,13 +2144,37 @@ Info = _reflection.GeneratedProtocolMessageType('Info', (_message.Message,), {
     # @@protoc_insertion_point(class_scope:payload.Info.Agents)
     })
   ,
+
+  'AgentEvent' : _reflection.GeneratedProtocolMessageType('AgentEvent', (_message.Message,), {
+    'DESCRIPTOR' : _INFO_AGENTEVENT,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Info.AgentEvent)
+    })
+  ,
   'DESCRIPTOR' : _INFO,
   '__module__' : 'payload_pb2'
   # @@protoc_insertion_point(class_scope:payload.Info)
   })
 _sym_db.RegisterMessage(Info)
+_sym_db.RegisterMessage(Info.Index)
 _sym_db.RegisterMessage(Info.Agent)
 _sym_db.RegisterMessage(Info.Agents)
+_sym_db.RegisterMessage(Info.AgentEvent)
+
+Snapshot = _reflection.GeneratedProtocolMessageType('Snapshot', (_message.Message,), {
+
+  'Chunk' : _reflection.GeneratedProtocolMessageType('Chunk', (_message.Message,), {
+    'DESCRIPTOR' : _SNAPSHOT_CHUNK,
+    '__module__' : 'payload_pb2'
+    # @@protoc_insertion_point(class_scope:payload.Snapshot.Chunk)
+    })
+  ,
+  'DESCRIPTOR' : _SNAPSHOT,
+  '__module__' : 'payload_pb2'
+  # @@protoc_insertion_point(class_scope:payload.Snapshot)
+  })
+_sym_db.RegisterMessage(Snapshot)
+_sym_db.RegisterMessage(Snapshot.Chunk)
 
 Common = _reflection.GeneratedProtocolMessageType('Common', (_message.Message,), {
 
@@ -867,9 +2210,13 @@ _sym_db.RegisterMessage(Common.Errors)
 
 DESCRIPTOR._options = None
 _SEARCH_CONFIG.fields_by_name['num']._options = None
+_OBJECT_DISTANCE_METADATAENTRY._options = None
 _OBJECT_ID.fields_by_name['id']._options = None
-_OBJECT_VECTOR.fields_by_name['vector']._options = None
+_META_OBJECT_DATAENTRY._options = None
+_META_OBJECT.fields_by_name['id']._options = None
+_BACKUP_RESTOREREQUEST.fields_by_name['id']._options = None
 _CONTROLL_CREATEINDEXREQUEST.fields_by_name['pool_size']._options = None
+_CONTROLL_IMPORTREQUEST.fields_by_name['path']._options = None
 _INFO_AGENT.fields_by_name['ip']._options = None
 _INFO_AGENT.fields_by_name['count']._options = None
 _INFO_AGENTS.fields_by_name['Agents']._options = None
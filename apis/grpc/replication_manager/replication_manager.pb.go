@@ -4,10 +4,15 @@
 package replication_manager
 
 import (
+	context "context"
 	fmt "fmt"
 	_ "github.com/danielvladco/go-proto-gql/pb"
 	proto "github.com/gogo/protobuf/proto"
+	payload "github.com/vdaas/vald/apis/grpc/payload"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -25,14 +30,138 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("replication_manager.proto", fileDescriptor_f983d5516f249038) }
 
 var fileDescriptor_f983d5516f249038 = []byte{
-	// 142 bytes of a gzipped FileDescriptorProto
+	// 271 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4a, 0x2d, 0xc8,
 	0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x8b, 0xcf, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xc6, 0x22, 0x25, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e,
-	0x93, 0xaa, 0x9f, 0x58, 0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x02, 0x96, 0x2e, 0x86, 0x68,
-	0x91, 0xe2, 0x29, 0x48, 0xd2, 0x4f, 0x2f, 0xcc, 0x81, 0xf0, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x28, 0xe3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xb2, 0x94, 0xc4, 0xc4, 0x62, 0xfd, 0xb2, 0xc4, 0x9c, 0x14,
-	0x90, 0x41, 0xc5, 0xfa, 0xe9, 0x45, 0x05, 0xc9, 0xfa, 0x58, 0xac, 0x4b, 0x62, 0x03, 0x9b, 0x64,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x25, 0xb3, 0x0d, 0x8a, 0xa7, 0x00, 0x00, 0x00,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xc6, 0x22, 0x25, 0xc5, 0x5b, 0x90, 0x58, 0x99, 0x93,
+	0x9f, 0x98, 0x02, 0x51, 0x23, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90,
+	0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x02, 0x56, 0x5d, 0x0c, 0x95, 0xe5, 0x29, 0x48, 0xd2, 0x4f,
+	0x2f, 0xcc, 0x81, 0xf0, 0x8c, 0xce, 0x30, 0x72, 0x71, 0x07, 0x21, 0x8c, 0x14, 0x8a, 0xe4, 0x62,
+	0x0b, 0x2e, 0x49, 0x2c, 0x29, 0x2d, 0x16, 0x12, 0xd5, 0x83, 0x99, 0xea, 0x9c, 0x9f, 0x9b, 0x9b,
+	0x9f, 0xa7, 0xe7, 0x9a, 0x5b, 0x50, 0x52, 0x29, 0x25, 0x0d, 0x17, 0x46, 0xd2, 0xa6, 0x07, 0xd1,
+	0xa3, 0x24, 0xdd, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x51, 0x21, 0x61, 0x7d, 0x24, 0x67, 0xea, 0x17,
+	0x43, 0x0c, 0x8c, 0xe1, 0x62, 0xf1, 0x48, 0x4d, 0xcc, 0x21, 0xcb, 0x60, 0x85, 0x0d, 0x0f, 0xe4,
+	0x19, 0xc1, 0x86, 0x8b, 0x29, 0x09, 0xa2, 0x18, 0x9e, 0x91, 0x9a, 0x98, 0x63, 0xc5, 0xa8, 0x25,
+	0xc5, 0xb2, 0xe1, 0x81, 0x3c, 0x93, 0x53, 0xf5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0xc8, 0xa5, 0x94, 0x5f, 0x94, 0xae, 0x57, 0x96, 0x92, 0x98, 0x58, 0xac,
+	0x57, 0x96, 0x98, 0x93, 0xa2, 0x87, 0x25, 0xec, 0x9c, 0x84, 0x90, 0x6c, 0xf3, 0x85, 0x88, 0x05,
+	0x30, 0x46, 0x19, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x0d,
+	0xd0, 0x07, 0x19, 0x00, 0x0a, 0xd1, 0x62, 0xfd, 0xf4, 0xa2, 0x82, 0x64, 0x7d, 0x2c, 0x46, 0x25,
+	0xb1, 0x81, 0x83, 0xd4, 0x18, 0x30, 0x00, 0x8d, 0xcc, 0x67, 0xf7, 0xbf, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ReplicationClient is the client API for Replication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReplicationClient interface {
+	Status(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Replication_Status, error)
+	Heal(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Replication_Status, error)
+}
+
+type replicationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReplicationClient(cc *grpc.ClientConn) ReplicationClient {
+	return &replicationClient{cc}
+}
+
+func (c *replicationClient) Status(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Replication_Status, error) {
+	out := new(payload.Replication_Status)
+	err := c.cc.Invoke(ctx, "/replication_manager.Replication/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationClient) Heal(ctx context.Context, in *payload.Common_Empty, opts ...grpc.CallOption) (*payload.Replication_Status, error) {
+	out := new(payload.Replication_Status)
+	err := c.cc.Invoke(ctx, "/replication_manager.Replication/Heal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplicationServer is the server API for Replication service.
+type ReplicationServer interface {
+	Status(context.Context, *payload.Common_Empty) (*payload.Replication_Status, error)
+	Heal(context.Context, *payload.Common_Empty) (*payload.Replication_Status, error)
+}
+
+// UnimplementedReplicationServer can be embedded to have forward compatible implementations.
+type UnimplementedReplicationServer struct {
+}
+
+func (*UnimplementedReplicationServer) Status(ctx context.Context, req *payload.Common_Empty) (*payload.Replication_Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedReplicationServer) Heal(ctx context.Context, req *payload.Common_Empty) (*payload.Replication_Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heal not implemented")
+}
+
+func RegisterReplicationServer(s *grpc.Server, srv ReplicationServer) {
+	s.RegisterService(&_Replication_serviceDesc, srv)
+}
+
+func _Replication_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Common_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication_manager.Replication/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationServer).Status(ctx, req.(*payload.Common_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replication_Heal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Common_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationServer).Heal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication_manager.Replication/Heal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationServer).Heal(ctx, req.(*payload.Common_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Replication_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "replication_manager.Replication",
+	HandlerType: (*ReplicationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _Replication_Status_Handler,
+		},
+		{
+			MethodName: "Heal",
+			Handler:    _Replication_Heal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "replication_manager.proto",
 }
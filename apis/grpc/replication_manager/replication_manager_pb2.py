@@ -13,6 +13,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+import payload_pb2 as payload__pb2
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from pb import gql_pb2 as pb_dot_gql__pb2
 
@@ -21,10 +22,10 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='replication_manager.proto',
   package='replication_manager',
   syntax='proto3',
-  serialized_options=_b('Z3github.com/vdaas/vald/apis/grpc/replication_manager'),
-  serialized_pb=_b('\n\x19replication_manager.proto\x12\x13replication_manager\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.protoB5Z3github.com/vdaas/vald/apis/grpc/replication_managerb\x06proto3')
+  serialized_options=_b('\n\"org.vdaas.vald.replication_managerB\022ReplicationManagerP\001Z3github.com/vdaas/vald/apis/grpc/replication_manager'),
+  serialized_pb=_b('\n\x19replication_manager.proto\x12\x13replication_manager\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\xcc\x01\n\x0bReplication\x12Y\n\x06Status\x12\x15.payload.Common.Empty\x1a\x1b.payload.Replication.Status\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/replication/status\x12\\\n\x04Heal\x12\x15.payload.Common.Empty\x1a\x1b.payload.Replication.Status\" \x82\xd3\xe4\x93\x02\x16\"\x11/replication/heal:\x01*\xb0\xe0\x1f\x01\x1a\x04\xb0\xe0\x1f\x02\x42o\n\"org.vdaas.vald.replication_managerB\x12ReplicationManagerP\x01Z3github.com/vdaas/vald/apis/grpc/replication_managerb\x06proto3')
   ,
-  dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
+  dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
 
 
@@ -32,4 +33,37 @@ _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 
 DESCRIPTOR._options = None
+
+_REPLICATION = _descriptor.ServiceDescriptor(
+  name='Replication',
+  full_name='replication_manager.Replication',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=_b('\260\340\037\002'),
+  serialized_start=110,
+  serialized_end=314,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Status',
+    full_name='replication_manager.Replication.Status',
+    index=0,
+    containing_service=None,
+    input_type=payload__pb2._COMMON_EMPTY,
+    output_type=payload__pb2._REPLICATION_STATUS,
+    serialized_options=_b('\202\323\344\223\002\025\022\023/replication/status'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Heal',
+    full_name='replication_manager.Replication.Heal',
+    index=1,
+    containing_service=None,
+    input_type=payload__pb2._COMMON_EMPTY,
+    output_type=payload__pb2._REPLICATION_STATUS,
+    serialized_options=_b('\202\323\344\223\002\026\"\021/replication/heal:\001*\260\340\037\001'),
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_REPLICATION)
+
+DESCRIPTOR.services_by_name['Replication'] = _REPLICATION
+
 # @@protoc_insertion_point(module_scope)
@@ -1,3 +1,63 @@
 # Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
 import grpc
 
+import payload_pb2 as payload__pb2
+
+
+class ReplicationStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.Status = channel.unary_unary(
+        '/replication_manager.Replication/Status',
+        request_serializer=payload__pb2.Common.Empty.SerializeToString,
+        response_deserializer=payload__pb2.Replication.Status.FromString,
+        )
+    self.Heal = channel.unary_unary(
+        '/replication_manager.Replication/Heal',
+        request_serializer=payload__pb2.Common.Empty.SerializeToString,
+        response_deserializer=payload__pb2.Replication.Status.FromString,
+        )
+
+
+class ReplicationServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def Status(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Heal(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_ReplicationServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Status': grpc.unary_unary_rpc_method_handler(
+          servicer.Status,
+          request_deserializer=payload__pb2.Common.Empty.FromString,
+          response_serializer=payload__pb2.Replication.Status.SerializeToString,
+      ),
+      'Heal': grpc.unary_unary_rpc_method_handler(
+          servicer.Heal,
+          request_deserializer=payload__pb2.Common.Empty.FromString,
+          response_serializer=payload__pb2.Replication.Status.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'replication_manager.Replication', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
@@ -4,10 +4,15 @@
 package traffic_manager
 
 import (
+	context "context"
 	fmt "fmt"
 	_ "github.com/danielvladco/go-proto-gql/pb"
 	proto "github.com/gogo/protobuf/proto"
+	payload "github.com/vdaas/vald/apis/grpc/payload"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -25,14 +30,101 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("traffic_manager.proto", fileDescriptor_d948c4d9e0d10939) }
 
 var fileDescriptor_d948c4d9e0d10939 = []byte{
-	// 141 bytes of a gzipped FileDescriptorProto
+	// 246 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0x29, 0x4a, 0x4c,
 	0x4b, 0xcb, 0x4c, 0x8e, 0xcf, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x47, 0x13, 0x96, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x28, 0x97,
-	0xe2, 0x29, 0x48, 0xd2, 0x4f, 0x2f, 0xcc, 0x81, 0xf0, 0x9c, 0x6c, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x28, 0xfd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xb2, 0x94, 0xc4, 0xc4, 0x62, 0xfd, 0xb2, 0xc4, 0x9c, 0x14, 0x90,
-	0x41, 0xc5, 0xfa, 0xe9, 0x45, 0x05, 0xc9, 0xfa, 0x68, 0x56, 0x25, 0xb1, 0x81, 0x4d, 0x31, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xcf, 0x88, 0xef, 0x9b, 0x00, 0x00, 0x00,
+	0xc9, 0x17, 0xe2, 0x47, 0x13, 0x96, 0xe2, 0x2d, 0x48, 0xac, 0xcc, 0xc9, 0x4f, 0x4c, 0x81, 0xc8,
+	0x4b, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5,
+	0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x65, 0x79, 0x0a, 0x92, 0xf4, 0xd3, 0x0b,
+	0x73, 0x20, 0x3c, 0xa3, 0x02, 0x2e, 0xf6, 0x10, 0x88, 0x69, 0x42, 0xf1, 0x5c, 0xec, 0x8e, 0xc9,
+	0x85, 0xa5, 0x99, 0x45, 0xa9, 0x42, 0x12, 0x7a, 0x30, 0x13, 0xa1, 0x92, 0x7a, 0x41, 0xa9, 0x85,
+	0xa5, 0xa9, 0xc5, 0x25, 0x52, 0x92, 0x58, 0x64, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0xe4,
+	0x37, 0x3c, 0x90, 0x67, 0x6c, 0xba, 0xfc, 0x64, 0x32, 0x93, 0xa8, 0x92, 0x80, 0x3e, 0xd4, 0x8d,
+	0xfa, 0x89, 0x10, 0x23, 0xad, 0x18, 0xb5, 0xa4, 0x58, 0x36, 0x3c, 0x90, 0x67, 0x72, 0xca, 0x3f,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0xb9, 0xe4, 0xf2, 0x8b,
+	0xd2, 0xf5, 0xca, 0x52, 0x12, 0x13, 0x8b, 0xf5, 0xca, 0x12, 0x73, 0x52, 0xf4, 0xd0, 0xbc, 0xe6,
+	0xc4, 0x07, 0xb5, 0xc6, 0x17, 0xc2, 0x0f, 0x60, 0x8c, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
+	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x6b, 0xd4, 0x07, 0x69, 0x04, 0x79, 0xb6, 0x58, 0x3f, 0xbd,
+	0xa8, 0x20, 0x59, 0x1f, 0xcd, 0x88, 0x24, 0x36, 0xb0, 0x4f, 0x8d, 0x01, 0x03, 0x00, 0x7c, 0x14,
+	0xdd, 0xd3, 0x4e, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TrafficClient is the client API for Traffic service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TrafficClient interface {
+	Acquire(ctx context.Context, in *payload.Traffic_Request, opts ...grpc.CallOption) (*payload.Traffic_Response, error)
+}
+
+type trafficClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTrafficClient(cc *grpc.ClientConn) TrafficClient {
+	return &trafficClient{cc}
+}
+
+func (c *trafficClient) Acquire(ctx context.Context, in *payload.Traffic_Request, opts ...grpc.CallOption) (*payload.Traffic_Response, error) {
+	out := new(payload.Traffic_Response)
+	err := c.cc.Invoke(ctx, "/traffic_manager.Traffic/Acquire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrafficServer is the server API for Traffic service.
+type TrafficServer interface {
+	Acquire(context.Context, *payload.Traffic_Request) (*payload.Traffic_Response, error)
+}
+
+// UnimplementedTrafficServer can be embedded to have forward compatible implementations.
+type UnimplementedTrafficServer struct {
+}
+
+func (*UnimplementedTrafficServer) Acquire(ctx context.Context, req *payload.Traffic_Request) (*payload.Traffic_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acquire not implemented")
+}
+
+func RegisterTrafficServer(s *grpc.Server, srv TrafficServer) {
+	s.RegisterService(&_Traffic_serviceDesc, srv)
+}
+
+func _Traffic_Acquire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Traffic_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrafficServer).Acquire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/traffic_manager.Traffic/Acquire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrafficServer).Acquire(ctx, req.(*payload.Traffic_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Traffic_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "traffic_manager.Traffic",
+	HandlerType: (*TrafficServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Acquire",
+			Handler:    _Traffic_Acquire_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "traffic_manager.proto",
 }
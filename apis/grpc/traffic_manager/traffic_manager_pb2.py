@@ -13,6 +13,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+import payload_pb2 as payload__pb2
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from pb import gql_pb2 as pb_dot_gql__pb2
 
@@ -21,10 +22,10 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='traffic_manager.proto',
   package='traffic_manager',
   syntax='proto3',
-  serialized_options=_b('Z/github.com/vdaas/vald/apis/grpc/traffic_manager'),
-  serialized_pb=_b('\n\x15traffic_manager.proto\x12\x0ftraffic_manager\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.protoB1Z/github.com/vdaas/vald/apis/grpc/traffic_managerb\x06proto3')
+  serialized_options=_b('\n\036org.vdaas.vald.traffic_managerB\016TrafficManagerP\001Z/github.com/vdaas/vald/apis/grpc/traffic_manager'),
+  serialized_pb=_b('\n\x15traffic_manager.proto\x12\x0ftraffic_manager\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2p\n\x07Traffic\x12_\n\x07\x41\x63quire\x12\x18.payload.Traffic.Request\x1a\x19.payload.Traffic.Response\"\x1f\x82\xd3\xe4\x93\x02\x15\"\x10/traffic/acquire:\x01*\xb0\xe0\x1f\x01\x1a\x04\xb0\xe0\x1f\x02\x42\x63\n\x1eorg.vdaas.vald.traffic_managerB\x0eTrafficManagerP\x01Z/github.com/vdaas/vald/apis/grpc/traffic_managerb\x06proto3')
   ,
-  dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
+  dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
 
 
@@ -32,4 +33,28 @@ _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 
 DESCRIPTOR._options = None
+
+_TRAFFIC = _descriptor.ServiceDescriptor(
+  name='Traffic',
+  full_name='traffic_manager.Traffic',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=_b('\260\340\037\002'),
+  serialized_start=101,
+  serialized_end=213,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Acquire',
+    full_name='traffic_manager.Traffic.Acquire',
+    index=0,
+    containing_service=None,
+    input_type=payload__pb2._TRAFFIC_REQUEST,
+    output_type=payload__pb2._TRAFFIC_RESPONSE,
+    serialized_options=_b('\202\323\344\223\002\025\"\020/traffic/acquire:\001*\260\340\037\001'),
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_TRAFFIC)
+
+DESCRIPTOR.services_by_name['Traffic'] = _TRAFFIC
+
 # @@protoc_insertion_point(module_scope)
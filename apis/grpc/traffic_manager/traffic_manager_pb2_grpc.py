@@ -1,3 +1,46 @@
 # Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
 import grpc
 
+import payload_pb2 as payload__pb2
+
+
+class TrafficStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.Acquire = channel.unary_unary(
+        '/traffic_manager.Traffic/Acquire',
+        request_serializer=payload__pb2.Traffic.Request.SerializeToString,
+        response_deserializer=payload__pb2.Traffic.Response.FromString,
+        )
+
+
+class TrafficServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def Acquire(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_TrafficServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Acquire': grpc.unary_unary_rpc_method_handler(
+          servicer.Acquire,
+          request_deserializer=payload__pb2.Traffic.Request.FromString,
+          response_serializer=payload__pb2.Traffic.Response.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'traffic_manager.Traffic', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
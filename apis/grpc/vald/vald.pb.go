@@ -30,37 +30,39 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("vald.proto", fileDescriptor_33e68360ff34c546) }
 
 var fileDescriptor_33e68360ff34c546 = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0x87, 0x3b, 0x65, 0x89, 0x74, 0x8c, 0xab, 0x8c, 0xba, 0xad, 0x41, 0xb6, 0x10, 0x3c, 0x48,
-	0x0f, 0x33, 0xa2, 0x37, 0x11, 0x94, 0xdd, 0xad, 0x65, 0x85, 0x65, 0xa5, 0xa5, 0x3d, 0x78, 0x9b,
-	0x4d, 0x86, 0x34, 0x92, 0xe4, 0x4d, 0x67, 0x26, 0x8b, 0x45, 0xbc, 0xf8, 0x15, 0xfc, 0x0e, 0x9e,
-	0xfb, 0x31, 0x3c, 0x0a, 0x7e, 0x81, 0x65, 0xf1, 0x83, 0x48, 0x66, 0xb2, 0xa1, 0x7f, 0xb2, 0x82,
-	0xf1, 0x96, 0xbc, 0xef, 0xfc, 0x9e, 0x99, 0x67, 0x60, 0x5e, 0x8c, 0xe7, 0x3c, 0x09, 0x69, 0x2e,
-	0x41, 0x03, 0xe9, 0x94, 0xdf, 0xde, 0x9d, 0x9c, 0x9f, 0x27, 0xc0, 0xab, 0xa2, 0xf7, 0x38, 0x02,
-	0x88, 0x12, 0xc1, 0x78, 0x1e, 0x33, 0x9e, 0x65, 0xa0, 0xb9, 0x8e, 0x21, 0x53, 0x55, 0xd7, 0xcd,
-	0x67, 0x2c, 0x3a, 0x4b, 0xec, 0xdf, 0xf3, 0xef, 0x5b, 0xb8, 0x73, 0xc2, 0x93, 0x90, 0xbc, 0xc5,
-	0xce, 0xfe, 0xa7, 0x58, 0x69, 0x45, 0x08, 0x5d, 0xe1, 0xa6, 0xb3, 0x8f, 0x22, 0xd0, 0x74, 0x3c,
-	0xf2, 0x1a, 0x6a, 0xfe, 0x83, 0xaf, 0xbf, 0x7e, 0x7f, 0xdb, 0xec, 0x12, 0x97, 0x09, 0x13, 0x64,
-	0x9f, 0xe3, 0xf0, 0x0b, 0x99, 0x62, 0xe7, 0x48, 0x70, 0x19, 0x9c, 0x92, 0xed, 0x3a, 0x63, 0x0b,
-	0xf4, 0x50, 0x9c, 0x15, 0x42, 0x69, 0x6f, 0xe7, 0x66, 0x43, 0xe5, 0x90, 0x29, 0xe1, 0x13, 0x83,
-	0x74, 0xfd, 0x5b, 0x4c, 0x99, 0xce, 0x4b, 0xb4, 0x47, 0x8e, 0x31, 0xb6, 0xcb, 0x06, 0xe7, 0xe3,
-	0x11, 0x79, 0x74, 0x3d, 0x3b, 0x1e, 0xb5, 0xc3, 0x1e, 0x60, 0xf7, 0x48, 0x4b, 0xc1, 0xd3, 0xf6,
-	0xa7, 0xdd, 0x78, 0x8a, 0x9e, 0x21, 0x32, 0xc1, 0xf7, 0x2e, 0x83, 0xda, 0x9f, 0xd2, 0xe2, 0xa6,
-	0xd8, 0x19, 0x67, 0x4a, 0x48, 0x4d, 0x7a, 0xd7, 0xef, 0xfc, 0x44, 0x04, 0x1a, 0xa4, 0xf7, 0xb0,
-	0xae, 0x0f, 0x21, 0x4d, 0x21, 0xa3, 0xfb, 0x52, 0x82, 0xf4, 0x7b, 0x17, 0x8b, 0x5d, 0x54, 0x8b,
-	0xc6, 0x86, 0x51, 0x8a, 0x0e, 0x57, 0xa2, 0xed, 0xb0, 0xf6, 0x54, 0x6f, 0xf0, 0xed, 0x49, 0x91,
-	0xe8, 0xb8, 0x62, 0x6c, 0x37, 0x33, 0x94, 0xd7, 0x6b, 0x84, 0x28, 0x7f, 0xa3, 0xf4, 0x3a, 0xce,
-	0x43, 0xae, 0xc5, 0xff, 0x79, 0x15, 0x86, 0x71, 0xc5, 0xab, 0x1d, 0xf6, 0xaa, 0x57, 0xc5, 0x68,
-	0xe1, 0x35, 0xc1, 0xce, 0xa1, 0x48, 0x61, 0x2e, 0x1a, 0xdf, 0xcd, 0x9a, 0xcd, 0x77, 0x6a, 0xa7,
-	0xee, 0x9e, 0xcb, 0xa4, 0xc9, 0xdb, 0xe7, 0xf3, 0x7a, 0x65, 0xf5, 0xef, 0x50, 0x6b, 0xf4, 0xaa,
-	0x32, 0xaa, 0xf2, 0xf7, 0x6f, 0xe6, 0xff, 0x6e, 0xb3, 0x75, 0x20, 0xb4, 0x5d, 0xda, 0xb8, 0xf7,
-	0x9a, 0x5b, 0xbe, 0x34, 0x0c, 0xc0, 0xd4, 0xad, 0xcd, 0x10, 0xdf, 0xb5, 0x36, 0xed, 0xa0, 0xc6,
-	0xc8, 0xeb, 0x5c, 0x2c, 0x76, 0x37, 0x07, 0xef, 0x7e, 0x2c, 0xfb, 0xe8, 0xe7, 0xb2, 0x8f, 0x16,
-	0xcb, 0x3e, 0xc2, 0x5d, 0x90, 0x11, 0x9d, 0x87, 0x9c, 0x2b, 0x5a, 0x4e, 0xc0, 0x81, 0x99, 0x61,
-	0xef, 0xd1, 0x87, 0x27, 0x51, 0xac, 0x4f, 0x8b, 0x19, 0x0d, 0x20, 0x65, 0xa6, 0xcd, 0xca, 0x76,
-	0x39, 0x09, 0x15, 0x8b, 0x64, 0x1e, 0x98, 0xdf, 0x99, 0x63, 0x66, 0xdf, 0x8b, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x04, 0x48, 0xaf, 0xc1, 0x4a, 0x05, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x5f, 0x6b, 0xd3, 0x50,
+	0x18, 0x87, 0x97, 0x51, 0x22, 0x1e, 0xb3, 0x2a, 0x47, 0xed, 0xba, 0x30, 0x3a, 0x08, 0x5e, 0xc8,
+	0x2e, 0x72, 0x44, 0xef, 0x44, 0x50, 0xda, 0xce, 0x51, 0xa1, 0x54, 0x36, 0xb6, 0x0b, 0xaf, 0x3c,
+	0x4d, 0x0e, 0x59, 0x24, 0xc9, 0x9b, 0x9d, 0x73, 0x5a, 0x1c, 0xe2, 0x8d, 0x57, 0xde, 0xfb, 0x45,
+	0xf6, 0x31, 0xbc, 0x14, 0xfc, 0x02, 0xa5, 0xf8, 0x41, 0xe4, 0xfc, 0x69, 0xd9, 0xba, 0x54, 0x30,
+	0xbb, 0x4b, 0xde, 0x37, 0xbf, 0xe7, 0xe4, 0x79, 0x43, 0x5e, 0x84, 0xa6, 0x34, 0x8b, 0xc3, 0x92,
+	0x83, 0x04, 0xdc, 0x50, 0xd7, 0xfe, 0x56, 0x49, 0x2f, 0x32, 0xa0, 0xb6, 0xe8, 0xef, 0x26, 0x00,
+	0x49, 0xc6, 0x08, 0x2d, 0x53, 0x42, 0x8b, 0x02, 0x24, 0x95, 0x29, 0x14, 0xc2, 0x76, 0xbd, 0x72,
+	0x4c, 0x92, 0xf3, 0xcc, 0xdc, 0x3d, 0xff, 0x8e, 0x50, 0xe3, 0x94, 0x66, 0x31, 0x7e, 0x8b, 0xdc,
+	0x83, 0xcf, 0xa9, 0x90, 0x02, 0xe3, 0x70, 0x81, 0x1b, 0x8d, 0x3f, 0xb1, 0x48, 0x86, 0x83, 0xbe,
+	0x5f, 0x51, 0x0b, 0x1e, 0x7d, 0xfb, 0xfd, 0xe7, 0xc7, 0x66, 0x13, 0x7b, 0x84, 0xe9, 0x20, 0xf9,
+	0x92, 0xc6, 0x5f, 0xf1, 0x08, 0xb9, 0xc7, 0x8c, 0xf2, 0xe8, 0x0c, 0x6f, 0x2f, 0x33, 0xa6, 0x10,
+	0x1e, 0xb1, 0xf3, 0x09, 0x13, 0xd2, 0x6f, 0xdf, 0x6c, 0x88, 0x12, 0x0a, 0xc1, 0x02, 0xac, 0x91,
+	0x5e, 0x70, 0x87, 0x08, 0xdd, 0x79, 0xe9, 0xec, 0xe3, 0x13, 0x84, 0xcc, 0x63, 0xdd, 0x8b, 0x41,
+	0x1f, 0xef, 0xac, 0x66, 0x07, 0xfd, 0x7a, 0xd8, 0x8f, 0xe8, 0xde, 0x70, 0x92, 0xc9, 0xd4, 0xbe,
+	0xec, 0xee, 0x6a, 0x58, 0x37, 0x17, 0xe8, 0x9d, 0x75, 0x68, 0x11, 0xb4, 0x35, 0x1b, 0x07, 0x5b,
+	0x96, 0x4d, 0x72, 0x15, 0x54, 0x27, 0x1c, 0x22, 0xef, 0x58, 0x72, 0x46, 0xf3, 0xfa, 0xf3, 0xd8,
+	0x78, 0xea, 0x3c, 0x73, 0xf0, 0x10, 0x3d, 0xb8, 0x0a, 0xaa, 0x3f, 0x07, 0x83, 0x1b, 0x21, 0x77,
+	0x50, 0x08, 0xc6, 0x25, 0x6e, 0xad, 0x7e, 0xd5, 0x53, 0x16, 0x49, 0xe0, 0xfe, 0xe3, 0x65, 0xbd,
+	0x07, 0x79, 0x0e, 0x45, 0x78, 0xc0, 0x39, 0xf0, 0xa0, 0x75, 0x39, 0xdb, 0x73, 0x96, 0xa3, 0x4c,
+	0x35, 0x43, 0x89, 0xf6, 0x16, 0xa2, 0xf5, 0xb0, 0xe6, 0xad, 0xde, 0xd8, 0xef, 0x61, 0x19, 0xdb,
+	0xd5, 0x0c, 0xe1, 0xb7, 0x2a, 0x21, 0x22, 0xd8, 0x50, 0x5e, 0x27, 0x65, 0x4c, 0x25, 0xbb, 0x9d,
+	0xd7, 0x44, 0x33, 0xae, 0x79, 0xd5, 0xc3, 0x5e, 0xf7, 0xb2, 0x8c, 0x1a, 0x5e, 0x43, 0xe4, 0x1e,
+	0xb1, 0x1c, 0xa6, 0xac, 0xf2, 0xcf, 0x5c, 0x73, 0x78, 0x7b, 0xe9, 0xd4, 0xdc, 0xf7, 0x08, 0xd7,
+	0x79, 0xf3, 0x83, 0xbe, 0x5e, 0x58, 0xfd, 0x3f, 0xd4, 0x18, 0xbd, 0xb2, 0x46, 0x36, 0xff, 0xf0,
+	0x66, 0xfe, 0xdf, 0x36, 0x77, 0x0f, 0x99, 0x34, 0x8f, 0x56, 0x9e, 0xbd, 0x66, 0xca, 0x57, 0xd6,
+	0x0d, 0xe8, 0xba, 0xb1, 0xe9, 0xa1, 0xfb, 0xc6, 0xa6, 0x1e, 0x54, 0x1b, 0xf9, 0x8d, 0xcb, 0xd9,
+	0xde, 0x66, 0xf7, 0xdd, 0xcf, 0x79, 0xc7, 0xf9, 0x35, 0xef, 0x38, 0xb3, 0x79, 0xc7, 0x41, 0x4d,
+	0xe0, 0x49, 0x38, 0x8d, 0x29, 0x15, 0xa1, 0xda, 0xb1, 0x5d, 0xbd, 0x25, 0xdf, 0x3b, 0x1f, 0x9e,
+	0x24, 0xa9, 0x3c, 0x9b, 0x8c, 0xc3, 0x08, 0x72, 0xa2, 0xdb, 0x44, 0xb5, 0xd5, 0xae, 0x15, 0x24,
+	0xe1, 0x65, 0xa4, 0x6f, 0xc7, 0xae, 0xde, 0xae, 0x2f, 0xfe, 0x0e, 0x00, 0x19, 0xea, 0x26, 0x06,
+	0xac, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -78,6 +80,7 @@ type ValdClient interface {
 	Exists(ctx context.Context, in *payload.Object_ID, opts ...grpc.CallOption) (*payload.Object_ID, error)
 	Search(ctx context.Context, in *payload.Search_Request, opts ...grpc.CallOption) (*payload.Search_Response, error)
 	SearchByID(ctx context.Context, in *payload.Search_IDRequest, opts ...grpc.CallOption) (*payload.Search_Response, error)
+	MultiSearch(ctx context.Context, in *payload.Search_MultiRequest, opts ...grpc.CallOption) (*payload.Search_Responses, error)
 	StreamSearch(ctx context.Context, opts ...grpc.CallOption) (Vald_StreamSearchClient, error)
 	StreamSearchByID(ctx context.Context, opts ...grpc.CallOption) (Vald_StreamSearchByIDClient, error)
 	Insert(ctx context.Context, in *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Common_Error, error)
@@ -128,6 +131,15 @@ func (c *valdClient) SearchByID(ctx context.Context, in *payload.Search_IDReques
 	return out, nil
 }
 
+func (c *valdClient) MultiSearch(ctx context.Context, in *payload.Search_MultiRequest, opts ...grpc.CallOption) (*payload.Search_Responses, error) {
+	out := new(payload.Search_Responses)
+	err := c.cc.Invoke(ctx, "/vald.Vald/MultiSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *valdClient) StreamSearch(ctx context.Context, opts ...grpc.CallOption) (Vald_StreamSearchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Vald_serviceDesc.Streams[0], "/vald.Vald/StreamSearch", opts...)
 	if err != nil {
@@ -382,6 +394,7 @@ type ValdServer interface {
 	Exists(context.Context, *payload.Object_ID) (*payload.Object_ID, error)
 	Search(context.Context, *payload.Search_Request) (*payload.Search_Response, error)
 	SearchByID(context.Context, *payload.Search_IDRequest) (*payload.Search_Response, error)
+	MultiSearch(context.Context, *payload.Search_MultiRequest) (*payload.Search_Responses, error)
 	StreamSearch(Vald_StreamSearchServer) error
 	StreamSearchByID(Vald_StreamSearchByIDServer) error
 	Insert(context.Context, *payload.Object_Vector) (*payload.Common_Error, error)
@@ -410,6 +423,9 @@ func (*UnimplementedValdServer) Search(ctx context.Context, req *payload.Search_
 func (*UnimplementedValdServer) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchByID not implemented")
 }
+func (*UnimplementedValdServer) MultiSearch(ctx context.Context, req *payload.Search_MultiRequest) (*payload.Search_Responses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSearch not implemented")
+}
 func (*UnimplementedValdServer) StreamSearch(srv Vald_StreamSearchServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamSearch not implemented")
 }
@@ -508,6 +524,24 @@ func _Vald_SearchByID_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vald_MultiSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.Search_MultiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValdServer).MultiSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.Vald/MultiSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValdServer).MultiSearch(ctx, req.(*payload.Search_MultiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vald_StreamSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ValdServer).StreamSearch(&valdStreamSearchServer{stream})
 }
@@ -806,6 +840,10 @@ var _Vald_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchByID",
 			Handler:    _Vald_SearchByID_Handler,
 		},
+		{
+			MethodName: "MultiSearch",
+			Handler:    _Vald_MultiSearch_Handler,
+		},
 		{
 			MethodName: "Insert",
 			Handler:    _Vald_Insert_Handler,
@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='vald',
   syntax='proto3',
   serialized_options=_b('\n\016org.vdaas.valdB\004ValdP\001Z$github.com/vdaas/vald/apis/grpc/vald'),
-  serialized_pb=_b('\n\nvald.proto\x12\x04vald\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\xa6\t\n\x04Vald\x12\x46\n\x06\x45xists\x12\x12.payload.Object.ID\x1a\x12.payload.Object.ID\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/exists/{id}\x12O\n\x06Search\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x12\x82\xd3\xe4\x93\x02\x0c\"\x07/search:\x01*\x12U\n\nSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x12\x82\xd3\xe4\x93\x02\x0c\"\x07/search:\x01*\x12G\n\x0cStreamSearch\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12M\n\x10StreamSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12O\n\x06Insert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/insert:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamInsert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiInsert\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12O\n\x06Update\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/update:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamUpdate\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiUpdate\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12M\n\x06Remove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x18\x82\xd3\xe4\x93\x02\x0e*\x0c/remove/{id}\xb0\xe0\x1f\x01\x12?\n\x0cStreamRemove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12<\n\x0bMultiRemove\x12\x13.payload.Object.IDs\x1a\x16.payload.Common.Errors\"\x00\x12M\n\tGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/object/{id}\x12\x43\n\x0fStreamGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x00(\x01\x30\x01\x1a\x04\xb0\xe0\x1f\x02\x42>\n\x0eorg.vdaas.valdB\x04ValdP\x01Z$github.com/vdaas/vald/apis/grpc/valdb\x06proto3')
+  serialized_pb=_b('\n\nvald.proto\x12\x04vald\x1a\rpayload.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x0cpb/gql.proto2\x88\n\n\x04Vald\x12\x46\n\x06\x45xists\x12\x12.payload.Object.ID\x1a\x12.payload.Object.ID\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/exists/{id}\x12O\n\x06Search\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x12\x82\xd3\xe4\x93\x02\x0c\"\x07/search:\x01*\x12U\n\nSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x12\x82\xd3\xe4\x93\x02\x0c\"\x07/search:\x01*\x12`\n\x0bMultiSearch\x12\x1c.payload.Search.MultiRequest\x1a\x19.payload.Search.Responses\"\x18\x82\xd3\xe4\x93\x02\x12\"\r/search/multi:\x01*\x12G\n\x0cStreamSearch\x12\x17.payload.Search.Request\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12M\n\x10StreamSearchByID\x12\x19.payload.Search.IDRequest\x1a\x18.payload.Search.Response\"\x00(\x01\x30\x01\x12O\n\x06Insert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/insert:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamInsert\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiInsert\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12O\n\x06Update\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x16\x82\xd3\xe4\x93\x02\x0c\"\x07/update:\x01*\xb0\xe0\x1f\x01\x12\x43\n\x0cStreamUpdate\x12\x16.payload.Object.Vector\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12@\n\x0bMultiUpdate\x12\x17.payload.Object.Vectors\x1a\x16.payload.Common.Errors\"\x00\x12M\n\x06Remove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x18\x82\xd3\xe4\x93\x02\x0e*\x0c/remove/{id}\xb0\xe0\x1f\x01\x12?\n\x0cStreamRemove\x12\x12.payload.Object.ID\x1a\x15.payload.Common.Error\"\x00(\x01\x30\x01\x12<\n\x0bMultiRemove\x12\x13.payload.Object.IDs\x1a\x16.payload.Common.Errors\"\x00\x12M\n\tGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/object/{id}\x12\x43\n\x0fStreamGetObject\x12\x12.payload.Object.ID\x1a\x16.payload.Object.Vector\"\x00(\x01\x30\x01\x1a\x04\xb0\xe0\x1f\x02\x42>\n\x0eorg.vdaas.valdB\x04ValdP\x01Z$github.com/vdaas/vald/apis/grpc/valdb\x06proto3')
   ,
   dependencies=[payload__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,pb_dot_gql__pb2.DESCRIPTOR,])
 
@@ -41,7 +41,7 @@ _VALD = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=_b('\260\340\037\002'),
   serialized_start=80,
-  serialized_end=1270,
+  serialized_end=1368,
   methods=[
   _descriptor.MethodDescriptor(
     name='Exists',
@@ -70,10 +70,19 @@ _VALD = _descriptor.ServiceDescriptor(
     output_type=payload__pb2._SEARCH_RESPONSE,
     serialized_options=_b('\202\323\344\223\002\014\"\007/search:\001*'),
   ),
+  _descriptor.MethodDescriptor(
+    name='MultiSearch',
+    full_name='vald.Vald.MultiSearch',
+    index=3,
+    containing_service=None,
+    input_type=payload__pb2._SEARCH_MULTIREQUEST,
+    output_type=payload__pb2._SEARCH_RESPONSES,
+    serialized_options=_b('\202\323\344\223\002\022\"\r/search/multi:\001*'),
+  ),
   _descriptor.MethodDescriptor(
     name='StreamSearch',
     full_name='vald.Vald.StreamSearch',
-    index=3,
+    index=4,
     containing_service=None,
     input_type=payload__pb2._SEARCH_REQUEST,
     output_type=payload__pb2._SEARCH_RESPONSE,
@@ -82,7 +91,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamSearchByID',
     full_name='vald.Vald.StreamSearchByID',
-    index=4,
+    index=5,
     containing_service=None,
     input_type=payload__pb2._SEARCH_IDREQUEST,
     output_type=payload__pb2._SEARCH_RESPONSE,
@@ -91,7 +100,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Insert',
     full_name='vald.Vald.Insert',
-    index=5,
+    index=6,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -100,7 +109,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamInsert',
     full_name='vald.Vald.StreamInsert',
-    index=6,
+    index=7,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -109,7 +118,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='MultiInsert',
     full_name='vald.Vald.MultiInsert',
-    index=7,
+    index=8,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTORS,
     output_type=payload__pb2._COMMON_ERRORS,
@@ -118,7 +127,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Update',
     full_name='vald.Vald.Update',
-    index=8,
+    index=9,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -127,7 +136,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamUpdate',
     full_name='vald.Vald.StreamUpdate',
-    index=9,
+    index=10,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTOR,
     output_type=payload__pb2._COMMON_ERROR,
@@ -136,7 +145,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='MultiUpdate',
     full_name='vald.Vald.MultiUpdate',
-    index=10,
+    index=11,
     containing_service=None,
     input_type=payload__pb2._OBJECT_VECTORS,
     output_type=payload__pb2._COMMON_ERRORS,
@@ -145,7 +154,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Remove',
     full_name='vald.Vald.Remove',
-    index=11,
+    index=12,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._COMMON_ERROR,
@@ -154,7 +163,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamRemove',
     full_name='vald.Vald.StreamRemove',
-    index=12,
+    index=13,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._COMMON_ERROR,
@@ -163,7 +172,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='MultiRemove',
     full_name='vald.Vald.MultiRemove',
-    index=13,
+    index=14,
     containing_service=None,
     input_type=payload__pb2._OBJECT_IDS,
     output_type=payload__pb2._COMMON_ERRORS,
@@ -172,7 +181,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetObject',
     full_name='vald.Vald.GetObject',
-    index=14,
+    index=15,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._OBJECT_VECTOR,
@@ -181,7 +190,7 @@ _VALD = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='StreamGetObject',
     full_name='vald.Vald.StreamGetObject',
-    index=15,
+    index=16,
     containing_service=None,
     input_type=payload__pb2._OBJECT_ID,
     output_type=payload__pb2._OBJECT_VECTOR,
@@ -29,6 +29,11 @@ class ValdStub(object):
         request_serializer=payload__pb2.Search.IDRequest.SerializeToString,
         response_deserializer=payload__pb2.Search.Response.FromString,
         )
+    self.MultiSearch = channel.unary_unary(
+        '/vald.Vald/MultiSearch',
+        request_serializer=payload__pb2.Search.MultiRequest.SerializeToString,
+        response_deserializer=payload__pb2.Search.Responses.FromString,
+        )
     self.StreamSearch = channel.stream_stream(
         '/vald.Vald/StreamSearch',
         request_serializer=payload__pb2.Search.Request.SerializeToString,
@@ -121,6 +126,13 @@ class ValdServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def MultiSearch(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def StreamSearch(self, request_iterator, context):
     # missing associated documentation comment in .proto file
     pass
@@ -230,6 +242,11 @@ def add_ValdServicer_to_server(servicer, server):
           request_deserializer=payload__pb2.Search.IDRequest.FromString,
           response_serializer=payload__pb2.Search.Response.SerializeToString,
       ),
+      'MultiSearch': grpc.unary_unary_rpc_method_handler(
+          servicer.MultiSearch,
+          request_deserializer=payload__pb2.Search.MultiRequest.FromString,
+          response_serializer=payload__pb2.Search.Responses.SerializeToString,
+      ),
       'StreamSearch': grpc.stream_stream_rpc_method_handler(
           servicer.StreamSearch,
           request_deserializer=payload__pb2.Search.Request.FromString,
@@ -26,6 +26,7 @@ package main
 import (
 	"context"
 
+	_ "github.com/vdaas/vald/internal/dataset/hdf5"
 	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/params"
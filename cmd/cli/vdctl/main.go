@@ -1 +1,120 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package main provides program main
 package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/log"
+	"google.golang.org/grpc"
+)
+
+const usage = `usage: vdctl <command> [flags]
+
+commands:
+  import    import a fvecs, bvecs, ivecs, csv or hdf5 file on the agent into its index
+`
+
+func main() {
+	log.Init(log.DefaultGlg())
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = importDataset(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func importDataset(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	var (
+		addr     = fs.String("addr", "127.0.0.1:8082", "agent gRPC address")
+		format   = fs.String("format", "", "file format fvecs, bvecs, ivecs, csv or hdf5, guessed from the extension when empty")
+		idColumn = fs.Uint("id-column", 0, "1-based csv column holding the uuids, 0 assigns the uuids from the sequence")
+		idPrefix = fs.String("id-prefix", "", "prefix of the uuids assigned from the sequence")
+		header   = fs.Bool("header", false, "skip the first csv line")
+		hdf5Set  = fs.String("hdf5-dataset", "train", "hdf5 dataset holding the vectors")
+		poolSize = fs.Uint("pool-size", 0, "pool size of the index creation")
+		index    = fs.String("index", "", "index name, empty for the default index")
+	)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: vdctl import [flags] <path on the agent>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, *addr, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := agent.NewAgentClient(conn).Import(ctx, &payload.Controll_ImportRequest{
+		Path:        fs.Arg(0),
+		Format:      *format,
+		IdColumn:    uint32(*idColumn),
+		IdPrefix:    *idPrefix,
+		Header:      *header,
+		Hdf5Dataset: *hdf5Set,
+		PoolSize:    uint32(*poolSize),
+		Index:       *index,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			log.Infof("[%s] Done.", fs.Arg(0))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		log.Infof("[%s] %d inserted, %d failed", fs.Arg(0), p.GetInserted(), p.GetFailed())
+	}
+}
@@ -1,20 +1,50 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package main provides program main
 package main
 
 import (
 	"context"
 
-	"github.com/vdaas/vald/internal/config"
-	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/params"
 	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
+	ver "github.com/vdaas/vald/internal/version"
 	"github.com/vdaas/vald/pkg/discoverer/k8s/config"
 	"github.com/vdaas/vald/pkg/discoverer/k8s/usecase"
 )
 
+const (
+	// version represent the version
+	version    = "v0.0.1"
+	maxVersion = "v0.0.10"
+	minVersion = "v0.0.0"
+)
+
 func main() {
-	// Try recover befor kill process for dump panic errors
-	defer safety.Recover()
+	defer safety.RecoverWithError(nil)
 
 	log.Init(log.DefaultGlg())
 
@@ -28,34 +58,30 @@ func main() {
 	}
 
 	if p.ShowVersion() {
-		err = log.Infof("server version -> %s", config.GetVersion())
-		if err != nil {
-			log.Fatal(err)
-		}
+		log.Infof("server version -> %s", version)
 		return
 	}
 
-	var stg config.Data
-	err := config.Read(p.ConfigFilePath(), &stg)
+	cfg, err := config.NewConfig(p.ConfigFilePath())
 	if err != nil {
 		log.Fatal(err)
 		return
 	}
 
-	if stg.Version != config.GetVersion() {
-		log.Fatal(errors.ErrInvalidConfig)
+	err = ver.Check(cfg.Version, maxVersion, minVersion)
+	if err != nil {
+		log.Fatal(err)
 		return
 	}
 
-	daemon, err := usecase.New(stg)
+	daemon, err := usecase.New(cfg)
 	if err != nil {
 		log.Fatal(err)
 		return
 	}
 
-	errs := runner.Run(context.Background(), daemon)
-	if len(errs) > 0 {
-		log.Fatal(errs)
-		return
+	err = runner.Run(errgroup.Init(context.Background()), daemon)
+	if err != nil {
+		log.Fatal(err)
 	}
 }
@@ -3,13 +3,17 @@ module github.com/vdaas/vald
 go 1.12
 
 require (
+	github.com/alicebob/miniredis/v2 v2.11.0
+	github.com/aws/aws-sdk-go v1.25.48
 	github.com/certifi/gocertifi v0.0.0-20190905060710-a5e0173ced67 // indirect
 	github.com/cockroachdb/errors v1.2.3
 	github.com/cockroachdb/logtags v0.0.0-20190617123548-eb05cc24525f // indirect
 	github.com/danielvladco/go-proto-gql/pb v0.6.1
 	github.com/envoyproxy/protoc-gen-validate v0.1.0
 	github.com/getsentry/raven-go v0.2.0 // indirect
+	github.com/go-redis/redis v6.15.6+incompatible
 	github.com/gogo/protobuf v1.3.0
+	github.com/golang/protobuf v1.3.2
 	github.com/gorilla/mux v1.7.3
 	github.com/hashicorp/go-version v1.2.0
 	github.com/kpango/fastime v1.0.15
@@ -17,10 +21,14 @@ require (
 	github.com/kpango/glg v1.4.6
 	github.com/pkg/errors v0.8.1 // indirect
 	github.com/yahoojapan/gongt v0.0.0-20190517050727-966dcc7aa5e8
+	go.etcd.io/bbolt v1.3.5
 	golang.org/x/sync v0.0.0-20190423024810-112230192c58
-	golang.org/x/sys v0.0.0-20190904154756-749cb33beabd
+	golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5
 	gonum.org/v1/hdf5 v0.0.0-20190516085527-847297cb569e
 	google.golang.org/genproto v0.0.0-20190905072037-92dd089d5514
 	google.golang.org/grpc v1.23.0
-	gopkg.in/yaml.v2 v2.2.2
+	gopkg.in/yaml.v2 v2.2.4
+	k8s.io/api v0.17.0
+	k8s.io/apimachinery v0.17.0
+	k8s.io/client-go v0.17.0
 )
@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package archive provides tar archiving of a directory
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTarUntar(t *testing.T) {
+	files := map[string]string{
+		"grp":            "graph",
+		"obj":            "object",
+		"ngt-meta.kvsdb": "mapping",
+		"tre/sub":        "nested",
+	}
+
+	src, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestTarUntar(%v)", err)
+	}
+	defer os.RemoveAll(src)
+	for name, body := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), 0700)
+		if err := ioutil.WriteFile(path, []byte(body), 0600); err != nil {
+			t.Errorf("Unexpected error: TestTarUntar(%v)", err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := Tar(buf, src); err != nil {
+		t.Fatalf("Unexpected error: TestTarUntar(%v)", err)
+	}
+
+	dst, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestTarUntar(%v)", err)
+	}
+	defer os.RemoveAll(dst)
+	if err := Untar(buf, dst); err != nil {
+		t.Fatalf("Unexpected error: TestTarUntar(%v)", err)
+	}
+
+	for name, want := range files {
+		got, err := ioutil.ReadFile(filepath.Join(dst, filepath.FromSlash(name)))
+		if err != nil {
+			t.Errorf("Unexpected error: TestTarUntar(%v)", err)
+		}
+		if string(got) != want {
+			t.Errorf("TestTarUntar(%s): %s, wanted: %s", name, got, want)
+		}
+	}
+}
+
+func TestUntarRejectsEscape(t *testing.T) {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	body := []byte("evil")
+	tw.WriteHeader(&tar.Header{
+		Name:     "../evil",
+		Mode:     0600,
+		Size:     int64(len(body)),
+		Typeflag: tar.TypeReg,
+	})
+	tw.Write(body)
+	tw.Close()
+
+	dst, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestUntarRejectsEscape(%v)", err)
+	}
+	defer os.RemoveAll(dst)
+
+	if err := Untar(buf, dst); err == nil {
+		t.Error("TestUntarRejectsEscape: escaping entry was extracted")
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(dst), "evil")); err == nil {
+		t.Error("TestUntarRejectsEscape: escaping file exists")
+	}
+}
@@ -0,0 +1,132 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Backup represent the configuration of the backup manager storing the agent snapshots.
+type Backup struct {
+	// DiscovererAddr represent the discoverer gRPC address which lists the agents to backup
+	DiscovererAddr string `json:"discoverer_addr" yaml:"discoverer_addr"`
+
+	// AgentPort represent the gRPC port of the agents
+	AgentPort int `json:"agent_port" yaml:"agent_port"`
+
+	// BackupDuration represent the interval of backing up all the agents, the periodic backup is disabled when empty
+	BackupDuration string `json:"backup_duration" yaml:"backup_duration"`
+
+	// MaxBackups represent the number of the backups kept for each agent index, unlimited when 0
+	MaxBackups int `json:"max_backups" yaml:"max_backups"`
+
+	// MaxAge represent the age after which the backups are deleted, the newest backup is always kept
+	MaxAge string `json:"max_age" yaml:"max_age"`
+
+	// Storage represent the blob storage of the backups
+	Storage *BlobStorage `json:"storage" yaml:"storage"`
+}
+
+// BlobStorage represent the configuration of the blob storage.
+type BlobStorage struct {
+	// StorageType represent the storage backend, fs or s3
+	StorageType string `json:"type" yaml:"type"`
+
+	// FS represent the local filesystem storage configuration
+	FS *FS `json:"fs" yaml:"fs"`
+
+	// S3 represent the S3 compatible object storage configuration
+	S3 *S3 `json:"s3" yaml:"s3"`
+}
+
+// FS represent the configuration of the local filesystem storage.
+type FS struct {
+	// Dir represent the root directory of the blobs
+	Dir string `json:"dir" yaml:"dir"`
+}
+
+// S3 represent the configuration of the S3 compatible object storage.
+type S3 struct {
+	// Endpoint represent the endpoint url, the AWS endpoint of the region is used when empty
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+
+	// Region represent the region of the bucket
+	Region string `json:"region" yaml:"region"`
+
+	// Bucket represent the bucket name
+	Bucket string `json:"bucket" yaml:"bucket"`
+
+	// Prefix represent the key prefix of the blobs
+	Prefix string `json:"prefix" yaml:"prefix"`
+
+	// AccessKey represent the access key id
+	AccessKey string `json:"access_key" yaml:"access_key"`
+
+	// SecretKey represent the secret access key
+	SecretKey string `json:"secret_key" yaml:"secret_key"`
+
+	// ForcePathStyle represent whether the bucket is addressed by the path instead of the host name
+	ForcePathStyle bool `json:"force_path_style" yaml:"force_path_style"`
+
+	// PartSize represent the size of each part of the multipart upload
+	PartSize int64 `json:"part_size" yaml:"part_size"`
+}
+
+func (b *Backup) Bind() *Backup {
+	b.DiscovererAddr = GetActualValue(b.DiscovererAddr)
+	b.BackupDuration = GetActualValue(b.BackupDuration)
+	b.MaxAge = GetActualValue(b.MaxAge)
+	if b.Storage != nil {
+		b.Storage = b.Storage.Bind()
+	} else {
+		b.Storage = new(BlobStorage).Bind()
+	}
+	return b
+}
+
+func (b *BlobStorage) Bind() *BlobStorage {
+	b.StorageType = GetActualValue(b.StorageType)
+	if b.FS != nil {
+		b.FS = b.FS.Bind()
+	} else {
+		b.FS = new(FS)
+	}
+	if b.S3 != nil {
+		b.S3 = b.S3.Bind()
+	} else {
+		b.S3 = new(S3)
+	}
+	return b
+}
+
+func (f *FS) Bind() *FS {
+	f.Dir = GetActualValue(f.Dir)
+	return f
+}
+
+func (s *S3) Bind() *S3 {
+	s.Endpoint = GetActualValue(s.Endpoint)
+	s.Region = GetActualValue(s.Region)
+	s.Bucket = GetActualValue(s.Bucket)
+	s.Prefix = GetActualValue(s.Prefix)
+	s.AccessKey = GetActualValue(s.AccessKey)
+	s.SecretKey = GetActualValue(s.SecretKey)
+	return s
+}
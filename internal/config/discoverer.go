@@ -0,0 +1,173 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Discoverer represent the configuration of the discoverer listing the agents.
+type Discoverer struct {
+	// Kubeconfig represent the kubeconfig file path, the in-cluster configuration is used when empty
+	Kubeconfig string `json:"kubeconfig" yaml:"kubeconfig"`
+
+	// Namespace represent the namespace of the agent pods, all namespaces are watched when empty
+	Namespace string `json:"namespace" yaml:"namespace"`
+
+	// LabelSelector represent the label selector of the agent pods
+	LabelSelector string `json:"label_selector" yaml:"label_selector"`
+
+	// ResyncPeriod represent the interval of re-listing the agent pods
+	ResyncPeriod string `json:"resync_period" yaml:"resync_period"`
+
+	// AgentPort represent the gRPC port of the agents
+	AgentPort int `json:"agent_port" yaml:"agent_port"`
+
+	// CountObjects represent whether the discoverer asks each agent for its object count
+	CountObjects bool `json:"count_objects" yaml:"count_objects"`
+
+	// CountTimeout represent the timeout of asking the agents for their object count
+	CountTimeout string `json:"count_timeout" yaml:"count_timeout"`
+}
+
+func (d *Discoverer) Bind() *Discoverer {
+	d.Kubeconfig = GetActualValue(d.Kubeconfig)
+	d.Namespace = GetActualValue(d.Namespace)
+	d.LabelSelector = GetActualValue(d.LabelSelector)
+	d.ResyncPeriod = GetActualValue(d.ResyncPeriod)
+	d.CountTimeout = GetActualValue(d.CountTimeout)
+	return d
+}
+
+// StaticDiscoverer represent the configuration of the discoverer serving the agents listed in its configuration file.
+type StaticDiscoverer struct {
+	// Agents represent the agent ips
+	Agents []string `json:"agents" yaml:"agents"`
+
+	// ReloadDuration represent the interval of re-reading the agents from the configuration file
+	ReloadDuration string `json:"reload_duration" yaml:"reload_duration"`
+}
+
+func (s *StaticDiscoverer) Bind() *StaticDiscoverer {
+	for i, a := range s.Agents {
+		s.Agents[i] = GetActualValue(a)
+	}
+	s.ReloadDuration = GetActualValue(s.ReloadDuration)
+	return s
+}
+
+// DNSDiscoverer represent the configuration of the discoverer resolving the agents by DNS.
+type DNSDiscoverer struct {
+	// Name represent the DNS name of the agents
+	Name string `json:"name" yaml:"name"`
+
+	// Service represent the SRV record service, the A records of Name are resolved when empty
+	// and the SRV record ports are not used as the agents serve on the gateway agent port
+	Service string `json:"service" yaml:"service"`
+
+	// Proto represent the SRV record protocol
+	Proto string `json:"proto" yaml:"proto"`
+
+	// RefreshDuration represent the interval of resolving the agents
+	RefreshDuration string `json:"refresh_duration" yaml:"refresh_duration"`
+
+	// TCP represent the dialer configuration of the resolver
+	TCP *TCP `json:"tcp" yaml:"tcp"`
+}
+
+func (d *DNSDiscoverer) Bind() *DNSDiscoverer {
+	d.Name = GetActualValue(d.Name)
+	d.Service = GetActualValue(d.Service)
+	d.Proto = GetActualValue(d.Proto)
+	d.RefreshDuration = GetActualValue(d.RefreshDuration)
+	if d.TCP != nil {
+		d.TCP = d.TCP.Bind()
+	} else {
+		d.TCP = new(TCP)
+	}
+	return d
+}
+
+// OpenStackDiscoverer represent the configuration of the discoverer listing the agent servers by the OpenStack compute API.
+type OpenStackDiscoverer struct {
+	// AuthURL represent the Keystone v3 identity endpoint
+	AuthURL string `json:"auth_url" yaml:"auth_url"`
+
+	// Username represent the user name of the password authentication
+	Username string `json:"username" yaml:"username"`
+
+	// Password represent the password of the user
+	Password string `json:"password" yaml:"password"`
+
+	// UserDomainName represent the domain name of the user
+	UserDomainName string `json:"user_domain_name" yaml:"user_domain_name"`
+
+	// ProjectName represent the project name of the token scope
+	ProjectName string `json:"project_name" yaml:"project_name"`
+
+	// ProjectDomainName represent the domain name of the project
+	ProjectDomainName string `json:"project_domain_name" yaml:"project_domain_name"`
+
+	// Region represent the region of the compute endpoint, the first compute endpoint is used when empty
+	Region string `json:"region" yaml:"region"`
+
+	// EndpointInterface represent the interface of the compute endpoint in the service catalog
+	EndpointInterface string `json:"endpoint_interface" yaml:"endpoint_interface"`
+
+	// MetadataKey represent the server metadata key of the agents
+	MetadataKey string `json:"metadata_key" yaml:"metadata_key"`
+
+	// MetadataValue represent the server metadata value of the agents, any value matches when empty
+	MetadataValue string `json:"metadata_value" yaml:"metadata_value"`
+
+	// Network represent the network name of the agent fixed ips, all networks are used when empty
+	Network string `json:"network" yaml:"network"`
+
+	// RefreshDuration represent the interval of listing the agent servers
+	RefreshDuration string `json:"refresh_duration" yaml:"refresh_duration"`
+
+	// Timeout represent the timeout of each API request
+	Timeout string `json:"timeout" yaml:"timeout"`
+
+	// TCP represent the dialer configuration of the API client
+	TCP *TCP `json:"tcp" yaml:"tcp"`
+}
+
+func (o *OpenStackDiscoverer) Bind() *OpenStackDiscoverer {
+	o.AuthURL = GetActualValue(o.AuthURL)
+	o.Username = GetActualValue(o.Username)
+	o.Password = GetActualValue(o.Password)
+	o.UserDomainName = GetActualValue(o.UserDomainName)
+	o.ProjectName = GetActualValue(o.ProjectName)
+	o.ProjectDomainName = GetActualValue(o.ProjectDomainName)
+	o.Region = GetActualValue(o.Region)
+	o.EndpointInterface = GetActualValue(o.EndpointInterface)
+	o.MetadataKey = GetActualValue(o.MetadataKey)
+	o.MetadataValue = GetActualValue(o.MetadataValue)
+	o.Network = GetActualValue(o.Network)
+	o.RefreshDuration = GetActualValue(o.RefreshDuration)
+	o.Timeout = GetActualValue(o.Timeout)
+	if o.TCP != nil {
+		o.TCP = o.TCP.Bind()
+	} else {
+		o.TCP = new(TCP)
+	}
+	return o
+}
@@ -0,0 +1,62 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Gateway represent the configuration of the gateway proxying the requests to the agents.
+type Gateway struct {
+	// DiscovererAddr represent the discoverer gRPC address which lists the agents
+	DiscovererAddr string `json:"discoverer_addr" yaml:"discoverer_addr"`
+
+	// MetaAddr represent the meta manager gRPC address which the search results are joined with
+	MetaAddr string `json:"meta_addr" yaml:"meta_addr"`
+
+	// TrafficAddr represent the traffic manager gRPC address which the requests are limited by
+	TrafficAddr string `json:"traffic_addr" yaml:"traffic_addr"`
+
+	// Traffic represent the limits of the tenants enforced by the gateway itself when the traffic manager is not configured
+	Traffic *Traffic `json:"traffic" yaml:"traffic"`
+
+	// DiscoveryDuration represent the interval of reconnecting the agent watch, or of polling the discoverer not supporting the watch
+	DiscoveryDuration string `json:"discovery_duration" yaml:"discovery_duration"`
+
+	// AgentPort represent the gRPC port of the agents
+	AgentPort int `json:"agent_port" yaml:"agent_port"`
+
+	// VirtualNodes represent the number of the points of each agent on the consistent hash ring
+	VirtualNodes int `json:"virtual_nodes" yaml:"virtual_nodes"`
+
+	// Replicas represent the number of the agents owning each uuid
+	Replicas int `json:"replicas" yaml:"replicas"`
+}
+
+func (g *Gateway) Bind() *Gateway {
+	g.DiscovererAddr = GetActualValue(g.DiscovererAddr)
+	g.MetaAddr = GetActualValue(g.MetaAddr)
+	g.TrafficAddr = GetActualValue(g.TrafficAddr)
+	if g.Traffic != nil {
+		g.Traffic = g.Traffic.Bind()
+	}
+	g.DiscoveryDuration = GetActualValue(g.DiscoveryDuration)
+	return g
+}
@@ -0,0 +1,112 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Meta represent the configuration of the meta manager store.
+type Meta struct {
+	// Store represent the store backend of the metadata, bbolt or redis
+	Store string `json:"store" yaml:"store"`
+
+	// BBolt represent the embedded bbolt store configuration
+	BBolt *BBolt `json:"bbolt" yaml:"bbolt"`
+
+	// Redis represent the redis store configuration
+	Redis *Redis `json:"redis" yaml:"redis"`
+}
+
+// BBolt represent the configuration of the embedded bbolt store.
+type BBolt struct {
+	// Path represent the database file path
+	Path string `json:"path" yaml:"path"`
+
+	// Bucket represent the bucket name of the metadata
+	Bucket string `json:"bucket" yaml:"bucket"`
+
+	// OpenTimeout represent the timeout of waiting for the database file lock
+	OpenTimeout string `json:"open_timeout" yaml:"open_timeout"`
+
+	// NoSync represent whether fsync after each commit is disabled
+	NoSync bool `json:"no_sync" yaml:"no_sync"`
+}
+
+// Redis represent the configuration of the redis store.
+type Redis struct {
+	// Addrs represent the redis server addresses, the cluster client is used for the multiple addresses
+	Addrs []string `json:"addrs" yaml:"addrs"`
+
+	// Password represent the redis password
+	Password string `json:"password" yaml:"password"`
+
+	// DB represent the redis database of the single server
+	DB int `json:"db" yaml:"db"`
+
+	// KeyPrefix represent the prefix of the metadata keys
+	KeyPrefix string `json:"key_prefix" yaml:"key_prefix"`
+
+	// DialTimeout represent the timeout of connecting to redis
+	DialTimeout string `json:"dial_timeout" yaml:"dial_timeout"`
+
+	// ReadTimeout represent the timeout of reading the redis response
+	ReadTimeout string `json:"read_timeout" yaml:"read_timeout"`
+
+	// WriteTimeout represent the timeout of writing the redis command
+	WriteTimeout string `json:"write_timeout" yaml:"write_timeout"`
+
+	// PoolSize represent the maximum number of the redis connections
+	PoolSize int `json:"pool_size" yaml:"pool_size"`
+}
+
+func (m *Meta) Bind() *Meta {
+	m.Store = GetActualValue(m.Store)
+	if m.BBolt != nil {
+		m.BBolt = m.BBolt.Bind()
+	} else {
+		m.BBolt = new(BBolt)
+	}
+	if m.Redis != nil {
+		m.Redis = m.Redis.Bind()
+	} else {
+		m.Redis = new(Redis)
+	}
+	return m
+}
+
+func (b *BBolt) Bind() *BBolt {
+	b.Path = GetActualValue(b.Path)
+	b.Bucket = GetActualValue(b.Bucket)
+	b.OpenTimeout = GetActualValue(b.OpenTimeout)
+	return b
+}
+
+func (r *Redis) Bind() *Redis {
+	for i, addr := range r.Addrs {
+		r.Addrs[i] = GetActualValue(addr)
+	}
+	r.Password = GetActualValue(r.Password)
+	r.KeyPrefix = GetActualValue(r.KeyPrefix)
+	r.DialTimeout = GetActualValue(r.DialTimeout)
+	r.ReadTimeout = GetActualValue(r.ReadTimeout)
+	r.WriteTimeout = GetActualValue(r.WriteTimeout)
+	return r
+}
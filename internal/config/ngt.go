@@ -25,6 +25,9 @@ package config
 
 // NGT represent the ngt core configuration for server.
 type NGT struct {
+	// Name represent the index name which requests use to select the index, empty for the default index
+	Name string `yaml:"name"`
+
 	// IndexPath represent the ngt index file path
 	IndexPath string `yaml:"index_path"`
 
@@ -34,6 +37,9 @@ type NGT struct {
 	// BulkInsertChunkSize represent the bulk insert chunk size
 	BulkInsertChunkSize int `yaml:"bulk_insert_chunk_size"`
 
+	// BulkSearchPoolSize represent the worker count of the bulk search
+	BulkSearchPoolSize int `yaml:"bulk_search_pool_size"`
+
 	// DistanceType represent the ngt index distance type
 	DistanceType string `yaml:"distance_type"`
 
@@ -45,11 +51,48 @@ type NGT struct {
 
 	// SearchEdgeSize represent the search edge size
 	SearchEdgeSize int `yaml:"search_edge_size"`
+
+	// FilterOverFetchRatio represent the multiplier of the search size used by the uuid filtered search
+	FilterOverFetchRatio int `yaml:"filter_over_fetch_ratio"`
+
+	// EnableDoubleBuffer represent whether CreateIndex builds on a copy of the index to keep serving Search,
+	// which requires twice the index memory during the build
+	EnableDoubleBuffer bool `yaml:"enable_double_buffer"`
+
+	// WALSyncPolicy represent the write-ahead log fsync policy always, interval or none
+	WALSyncPolicy string `yaml:"wal_sync_policy"`
+
+	// WALSyncInterval represent the write-ahead log fsync interval used by interval policy
+	WALSyncInterval string `yaml:"wal_sync_interval"`
+
+	// AutoIndexCheckDuration represent the duration of checking the uncommitted insert count
+	AutoIndexCheckDuration string `yaml:"auto_index_check_duration"`
+
+	// AutoIndexDurationLimit represent the maximum duration between automatic CreateIndex calls
+	AutoIndexDurationLimit string `yaml:"auto_index_duration_limit"`
+
+	// AutoSaveIndexDuration represent the duration of automatic SaveIndex calls
+	AutoSaveIndexDuration string `yaml:"auto_save_index_duration"`
+
+	// AutoIndexLength represent the uncommitted insert count which triggers automatic CreateIndex
+	AutoIndexLength int `yaml:"auto_index_length"`
+
+	// AutoIndexPoolSize represent the pool size used by automatic CreateIndex
+	AutoIndexPoolSize int `yaml:"auto_index_pool_size"`
+
+	// AutoCompactRemovedRatio represent the ratio of the removed objects which triggers automatic Compact, 0 disables it
+	AutoCompactRemovedRatio float64 `yaml:"auto_compact_removed_ratio"`
 }
 
 func (n *NGT) Bind() *NGT {
+	n.Name = GetActualValue(n.Name)
 	n.IndexPath = GetActualValue(n.IndexPath)
 	n.DistanceType = GetActualValue(n.DistanceType)
 	n.ObjectType = GetActualValue(n.ObjectType)
+	n.WALSyncPolicy = GetActualValue(n.WALSyncPolicy)
+	n.WALSyncInterval = GetActualValue(n.WALSyncInterval)
+	n.AutoIndexCheckDuration = GetActualValue(n.AutoIndexCheckDuration)
+	n.AutoIndexDurationLimit = GetActualValue(n.AutoIndexDurationLimit)
+	n.AutoSaveIndexDuration = GetActualValue(n.AutoSaveIndexDuration)
 	return n
 }
@@ -0,0 +1,55 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package config providers configuration type and load configuration logic
+package config
+
+// Replication represent the configuration of the replication manager healing the under-replicated uuids.
+type Replication struct {
+	// DiscovererAddr represent the discoverer gRPC address which lists the agents
+	DiscovererAddr string `json:"discoverer_addr" yaml:"discoverer_addr"`
+
+	// AgentPort represent the gRPC port of the agents
+	AgentPort int `json:"agent_port" yaml:"agent_port"`
+
+	// Index represent the name of the agent index to replicate, the default index when empty
+	Index string `json:"index" yaml:"index"`
+
+	// CheckDuration represent the interval of comparing the uuids held by the agents
+	CheckDuration string `json:"check_duration" yaml:"check_duration"`
+
+	// VirtualNodes represent the number of the points of each agent on the consistent hash ring, which must match the gateway
+	VirtualNodes int `json:"virtual_nodes" yaml:"virtual_nodes"`
+
+	// Replicas represent the number of the agents owning each uuid, which must match the gateway
+	Replicas int `json:"replicas" yaml:"replicas"`
+
+	// Concurrency represent the number of the uuids copied at once
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+}
+
+func (r *Replication) Bind() *Replication {
+	r.DiscovererAddr = GetActualValue(r.DiscovererAddr)
+	r.Index = GetActualValue(r.Index)
+	r.CheckDuration = GetActualValue(r.CheckDuration)
+	return r
+}
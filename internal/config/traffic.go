@@ -0,0 +1,103 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package config providers configuration type and load configuration logic
+package config
+
+import (
+	"github.com/vdaas/vald/internal/timeutil"
+	"github.com/vdaas/vald/internal/traffic"
+)
+
+// Traffic represent the configuration of the rate limits and the quotas of the tenants.
+type Traffic struct {
+	// DefaultTenant represent the tenant of the requests whose api key belongs to no tenant, such requests are rejected when empty
+	DefaultTenant string `json:"default_tenant" yaml:"default_tenant"`
+
+	// Tenants represent the tenants and their limits
+	Tenants []*Tenant `json:"tenants" yaml:"tenants"`
+}
+
+// Tenant represent the configuration of the limits of a tenant, the zero values are unlimited.
+type Tenant struct {
+	// Name represent the tenant name
+	Name string `json:"name" yaml:"name"`
+
+	// APIKeys represent the api keys of the tenant
+	APIKeys []string `json:"api_keys" yaml:"api_keys"`
+
+	// RequestsPerSecond represent the refill rate of the token bucket
+	RequestsPerSecond float64 `json:"requests_per_second" yaml:"requests_per_second"`
+
+	// Burst represent the size of the token bucket, which defaults to the requests per second
+	Burst int `json:"burst" yaml:"burst"`
+
+	// RequestQuota represent the number of the requests allowed in the quota duration
+	RequestQuota uint64 `json:"request_quota" yaml:"request_quota"`
+
+	// ObjectQuota represent the number of the objects written in the quota duration
+	ObjectQuota uint64 `json:"object_quota" yaml:"object_quota"`
+
+	// QuotaDuration represent the window of the quotas, the quotas never reset when empty
+	QuotaDuration string `json:"quota_duration" yaml:"quota_duration"`
+}
+
+func (t *Traffic) Bind() *Traffic {
+	t.DefaultTenant = GetActualValue(t.DefaultTenant)
+	for _, tn := range t.Tenants {
+		if tn != nil {
+			tn.Bind()
+		}
+	}
+	return t
+}
+
+func (t *Tenant) Bind() *Tenant {
+	t.Name = GetActualValue(t.Name)
+	for i, key := range t.APIKeys {
+		t.APIKeys[i] = GetActualValue(key)
+	}
+	t.QuotaDuration = GetActualValue(t.QuotaDuration)
+	return t
+}
+
+func (t *Traffic) Opts() ([]traffic.Option, error) {
+	opts := make([]traffic.Option, 0, len(t.Tenants)+1)
+	opts = append(opts, traffic.WithDefaultTenant(t.DefaultTenant))
+	for _, tn := range t.Tenants {
+		if tn == nil {
+			continue
+		}
+		d, err := timeutil.Parse(tn.QuotaDuration)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, traffic.WithTenant(tn.Name, traffic.Limit{
+			RequestsPerSecond: tn.RequestsPerSecond,
+			Burst:             tn.Burst,
+			RequestQuota:      tn.RequestQuota,
+			ObjectQuota:       tn.ObjectQuota,
+			QuotaDuration:     d,
+		}, tn.APIKeys...))
+	}
+	return opts, nil
+}
@@ -0,0 +1,124 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package ngt provides implementation of Go API for https://github.com/yahoojapan/NGT
+package ngt
+
+import (
+	"container/heap"
+	"math"
+	"math/bits"
+)
+
+// distanceFunc returns the distance function matching the NGT distance type.
+func distanceFunc(t distanceType) func(a, b []float64) float64 {
+	switch t {
+	case L1:
+		return l1
+	case L2:
+		return l2
+	case Angle, NormalizedAngle:
+		return angle
+	case Hamming:
+		return hamming
+	case Cosine, NormalizedCosine:
+		return cosine
+	}
+	return nil
+}
+
+func l1(a, b []float64) (d float64) {
+	for i := range a {
+		d += math.Abs(a[i] - b[i])
+	}
+	return d
+}
+
+func l2(a, b []float64) (d float64) {
+	for i := range a {
+		d += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(d)
+}
+
+func hamming(a, b []float64) (d float64) {
+	for i := range a {
+		d += float64(bits.OnesCount8(uint8(a[i]) ^ uint8(b[i])))
+	}
+	return d
+}
+
+func cosineSimilarity(a, b []float64) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return math.Max(-1, math.Min(1, dot/(math.Sqrt(na)*math.Sqrt(nb))))
+}
+
+func angle(a, b []float64) float64 {
+	return math.Acos(cosineSimilarity(a, b))
+}
+
+func cosine(a, b []float64) float64 {
+	return 1 - cosineSimilarity(a, b)
+}
+
+// resultHeap is a max heap of SearchResult by Distance used to keep the k nearest results.
+type resultHeap []SearchResult
+
+func (h resultHeap) Len() int            { return len(h) }
+func (h resultHeap) Less(i, j int) bool  { return h[i].Distance > h[j].Distance }
+func (h resultHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *resultHeap) Push(x interface{}) { *h = append(*h, x.(SearchResult)) }
+func (h *resultHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// push keeps the size nearest results in h.
+func (h *resultHeap) push(r SearchResult, size int) {
+	if h.Len() < size {
+		heap.Push(h, r)
+		return
+	}
+	if r.Distance < (*h)[0].Distance {
+		(*h)[0] = r
+		heap.Fix(h, 0)
+	}
+}
+
+// sorted returns the results in ascending order of distance, h is emptied.
+func (h *resultHeap) sorted() []SearchResult {
+	res := make([]SearchResult, h.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = heap.Pop(h).(SearchResult)
+	}
+	return res
+}
@@ -31,12 +31,17 @@ package ngt
 import "C"
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"unsafe"
 
+	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/safety"
 )
 
 type (
@@ -46,19 +51,43 @@ type (
 		// Search returns search result as []SearchResult
 		Search(vec []float64, size int, epsilon, radius float32) ([]SearchResult, error)
 
+		// SearchFloat32 returns search result as []SearchResult.
+		// The float32 vector is passed to NGT without conversion.
+		SearchFloat32(vec []float32, size int, epsilon, radius float32) ([]SearchResult, error)
+
+		// ExactSearch returns the exact nearest neighbors as []SearchResult
+		// by scanning all objects in the object space instead of the graph.
+		ExactSearch(vec []float64, size int) ([]SearchResult, error)
+
+		// BulkSearch returns search results of each vector as [][]SearchResult.
+		// The returned errors are aligned with vecs and nil for succeeded queries.
+		BulkSearch(vecs [][]float64, size int, epsilon, radius float32) ([][]SearchResult, []error)
+
 		// Insert returns NGT object id.
 		// This only stores not indexing, you must call CreateIndex and SaveIndex.
 		Insert(vec []float64) (uint, error)
 
+		// InsertFloat32 returns NGT object id.
+		// The float32 vector is passed to NGT without conversion.
+		// This only stores not indexing, you must call CreateIndex and SaveIndex.
+		InsertFloat32(vec []float32) (uint, error)
+
+		// InsertUint8 returns NGT object id.
+		// This only stores not indexing, you must call CreateIndex and SaveIndex.
+		InsertUint8(vec []uint8) (uint, error)
+
+		// SearchUint8 returns search result of the uint8 vector as []SearchResult.
+		SearchUint8(vec []uint8, size int, epsilon, radius float32) ([]SearchResult, error)
+
 		// InsertCommit returns NGT object id.
 		// This stores and indexes at the same time.
 		InsertCommit(vec []float64, poolSize uint32) (uint, error)
 
-		// BulkInsert returns NGT object ids.
+		// BulkInsert returns NGT object ids in the order of vecs, a failed insertion leaves 0 at its position.
 		// This only stores not indexing, you must call CreateIndex and SaveIndex.
 		BulkInsert(vecs [][]float64) ([]uint, []error)
 
-		// BulkInsertCommit returns NGT object ids.
+		// BulkInsertCommit returns NGT object ids in the order of vecs, a failed insertion leaves 0 at its position.
 		// This stores and indexes at the same time.
 		BulkInsertCommit(vecs [][]float64, poolSize uint32) ([]uint, []error)
 
@@ -71,12 +100,39 @@ type (
 		// SaveIndex stores NGT index to storage.
 		SaveIndex() error
 
+		// SaveIndexTo stores NGT index to the path instead of the index path.
+		SaveIndexTo(path string) error
+
+		// Replace loads the index stored in the path and replaces the current index with it.
+		// commit receives the number of the objects of the loaded index, exists, which looks up the object id in it,
+		// and swap, which replaces the index.
+		Replace(path string, commit func(objects uint64, exists func(id uint) error, swap func()) error) error
+
+		// Rebuild builds a new index from the live objects to drop the removed objects left in the graph.
+		// commit receives the old to new object id mapping and swap, which replaces the index.
+		Rebuild(poolSize uint32, commit func(ids map[uint]uint, swap func()) error) error
+
 		// Remove removes from NGT index.
 		Remove(id uint) error
 
 		// GetVector returns vector stored in NGT index.
 		GetVector(id uint) ([]float64, error)
 
+		// GetVectorFloat32 returns vector stored in NGT index as float32.
+		GetVectorFloat32(id uint) ([]float32, error)
+
+		// Property returns the property of NGT index.
+		Property() (*Property, error)
+
+		// ObjectCount returns the number of objects stored in NGT index including not indexed ones.
+		ObjectCount() uint64
+
+		// IndexedCount returns the number of objects indexed by CreateIndex.
+		IndexedCount() uint64
+
+		// RemovedCount returns the number of removed objects whose slots are not reused yet.
+		RemovedCount() uint64
+
 		// Close NGT index.
 		Close()
 	}
@@ -84,16 +140,29 @@ type (
 	ngt struct {
 		idxPath             string
 		bulkInsertChunkSize int
+		bulkSearchPoolSize  int
 		dimension           C.int32_t
 		objectType          objectType
+		distanceType        distanceType
 		prop                C.NGTProperty
 		ebuf                C.NGTError
 		index               C.NGTIndex
 		ospace              C.NGTObjectSpace
+		doubleBuffer        bool
 		mu                  *sync.RWMutex
+		wmu                 *sync.Mutex
 	}
 )
 
+// Property represents the property of NGT index.
+type Property struct {
+	Dimension        int
+	ObjectType       string
+	DistanceType     string
+	CreationEdgeSize int
+	SearchEdgeSize   int
+}
+
 // ObjectType is alias of object type in NGT
 type objectType int
 
@@ -126,6 +195,36 @@ const (
 	NormalizedCosine
 )
 
+func (o objectType) String() string {
+	switch o {
+	case Uint8:
+		return "Uint8"
+	case Float:
+		return "Float"
+	}
+	return "Unknown"
+}
+
+func (d distanceType) String() string {
+	switch d {
+	case L1:
+		return "L1"
+	case L2:
+		return "L2"
+	case Angle:
+		return "Angle"
+	case Hamming:
+		return "Hamming"
+	case Cosine:
+		return "Cosine"
+	case NormalizedAngle:
+		return "NormalizedAngle"
+	case NormalizedCosine:
+		return "NormalizedCosine"
+	}
+	return "Unknown"
+}
+
 // New returns NGT instance with recreating empty index file
 func New(opts ...Option) (NGT, error) {
 	return gen(false, opts...)
@@ -142,6 +241,7 @@ func gen(isLoad bool, opts ...Option) (NGT, error) {
 		err error
 	)
 	n.mu = new(sync.RWMutex)
+	n.wmu = new(sync.Mutex)
 
 	defer func() {
 		if err != nil {
@@ -235,6 +335,8 @@ func (n *ngt) open() error {
 	if int(n.dimension) == -1 {
 		return newGoError(n.ebuf)
 	}
+
+	n.distanceType = toDistanceType(C.ngt_get_property_distance_type(n.prop, n.ebuf))
 	return nil
 }
 
@@ -248,14 +350,127 @@ func (n *ngt) loadObjectSpace() error {
 
 // Search returns search result as []SearchResult
 func (n *ngt) Search(vec []float64, size int, epsilon, radius float32) ([]SearchResult, error) {
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
 
-	results := C.ngt_create_empty_results(n.ebuf)
-	defer C.ngt_destroy_results(results)
-	if results == nil {
-		return nil, newGoError(n.ebuf)
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.search(vec, size, epsilon, radius, ebuf)
+}
+
+// ExactSearch returns the exact nearest neighbors as []SearchResult
+// by scanning all objects in the object space instead of the graph.
+// It costs O(n) distance calculations and is meant for ground truth of recall measurement.
+func (n *ngt) ExactSearch(vec []float64, size int) ([]SearchResult, error) {
+	dimension := int(n.dimension)
+	if len(vec) != dimension {
+		return nil, errors.ErrInvalidDimensionSize(len(vec), dimension)
 	}
 
+	distance := distanceFunc(n.distanceType)
+	if distance == nil {
+		return nil, errors.ErrUnsupportedDistanceType
+	}
+	if size <= 0 {
+		return nil, nil
+	}
+
+	// removed objects set the error, so the scan uses its own NGTError
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
+
 	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	var (
+		h     = make(resultHeap, 0, size)
+		obj   = make([]float64, dimension)
+		rsize = uint(C.ngt_get_object_repository_size(n.index, ebuf))
+	)
+	for id := uint(1); id < rsize; id++ {
+		switch n.objectType {
+		case Float:
+			results := C.ngt_get_object_as_float(n.ospace, C.ObjectID(id), ebuf)
+			if results == nil {
+				C.ngt_clear_error_string(ebuf)
+				continue
+			}
+			for i, v := range (*[1 << 30]C.float)(unsafe.Pointer(results))[:dimension:dimension] {
+				obj[i] = float64(v)
+			}
+		case Uint8:
+			results := C.ngt_get_object_as_integer(n.ospace, C.ObjectID(id), ebuf)
+			if results == nil {
+				C.ngt_clear_error_string(ebuf)
+				continue
+			}
+			for i, v := range (*[1 << 30]C.uchar)(unsafe.Pointer(results))[:dimension:dimension] {
+				obj[i] = float64(v)
+			}
+		default:
+			return nil, errors.ErrUnsupportedObjectType
+		}
+		h.push(SearchResult{
+			ID:       uint32(id),
+			Distance: float32(distance(vec, obj)),
+		}, size)
+	}
+
+	return h.sorted(), nil
+}
+
+// BulkSearch returns search results of each vector as [][]SearchResult.
+// The queries run in parallel on the bulk search pool while holding one read lock,
+// so that no mutation or CreateIndex interleaves between them.
+// The returned errors are aligned with vecs and nil for succeeded queries.
+func (n *ngt) BulkSearch(vecs [][]float64, size int, epsilon, radius float32) ([][]SearchResult, []error) {
+	var (
+		res  = make([][]SearchResult, len(vecs))
+		errs = make([]error, len(vecs))
+		idx  = make(chan int, len(vecs))
+	)
+
+	for i := range vecs {
+		idx <- i
+	}
+	close(idx)
+
+	pool := n.bulkSearchPoolSize
+	if pool > len(vecs) {
+		pool = len(vecs)
+	}
+
+	_, eg := errgroup.New(context.Background())
+
+	n.mu.RLock()
+	for i := 0; i < pool; i++ {
+		eg.Go(safety.RecoverFunc(func() error {
+			// NGTError is not goroutine safe, each worker has its own one
+			ebuf := C.ngt_create_error_object()
+			defer C.ngt_destroy_error_object(ebuf)
+			for i := range idx {
+				res[i], errs[i] = n.search(vecs[i], size, epsilon, radius, ebuf)
+			}
+			return nil
+		}))
+	}
+	eg.Wait()
+	n.mu.RUnlock()
+
+	return res, errs
+}
+
+// search must be called while holding the read lock.
+func (n *ngt) search(vec []float64, size int, epsilon, radius float32, ebuf C.NGTError) ([]SearchResult, error) {
+	if len(vec) != int(n.dimension) {
+		return nil, errors.ErrInvalidDimensionSize(len(vec), int(n.dimension))
+	}
+
+	results := C.ngt_create_empty_results(ebuf)
+	defer C.ngt_destroy_results(results)
+	if results == nil {
+		return nil, newGoError(ebuf)
+	}
 
 	ret := C.ngt_search_index(n.index,
 		(*C.double)(&vec[0]),
@@ -267,24 +482,69 @@ func (n *ngt) Search(vec []float64, size int, epsilon, radius float32) ([]Search
 		*(*C.float)(unsafe.Pointer(&radius)),
 		// C.float(radius),
 		results,
-		n.ebuf)
+		ebuf)
+
+	if ret == ErrorCode {
+		return nil, newGoError(ebuf)
+	}
+
+	return toSearchResults(results, ebuf)
+}
+
+// SearchFloat32 returns search result as []SearchResult.
+// The float32 vector is passed to NGT without conversion.
+func (n *ngt) SearchFloat32(vec []float32, size int, epsilon, radius float32) ([]SearchResult, error) {
+	if len(vec) != int(n.dimension) {
+		return nil, errors.ErrInvalidDimensionSize(len(vec), int(n.dimension))
+	}
+
+	// searches run concurrently under the read lock, so the error object is not shared
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
+
+	results := C.ngt_create_empty_results(ebuf)
+	defer C.ngt_destroy_results(results)
+	if results == nil {
+		return nil, newGoError(ebuf)
+	}
+
+	n.mu.RLock()
+
+	ret := C.ngt_search_index_as_float(n.index,
+		(*C.float)(&vec[0]),
+		n.dimension,
+		*(*C.size_t)(unsafe.Pointer(&size)),
+		*(*C.float)(unsafe.Pointer(&epsilon)),
+		*(*C.float)(unsafe.Pointer(&radius)),
+		results,
+		ebuf)
 
 	n.mu.RUnlock()
 
 	if ret == ErrorCode {
-		return nil, newGoError(n.ebuf)
+		return nil, newGoError(ebuf)
 	}
 
-	rsize := int(C.ngt_get_size(results, n.ebuf))
+	return toSearchResults(results, ebuf)
+}
+
+// SearchUint8 returns search result of the uint8 vector as []SearchResult.
+// NGT C API has no uint8 entry point, so the vector is widened to float32 which represents every uint8 value exactly.
+func (n *ngt) SearchUint8(vec []uint8, size int, epsilon, radius float32) ([]SearchResult, error) {
+	return n.SearchFloat32(toFloat32(vec), size, epsilon, radius)
+}
+
+func toSearchResults(results C.NGTObjectDistances, ebuf C.NGTError) ([]SearchResult, error) {
+	rsize := int(C.ngt_get_size(results, ebuf))
 	if rsize == -1 {
-		return nil, newGoError(n.ebuf)
+		return nil, newGoError(ebuf)
 	}
 
 	result := make([]SearchResult, rsize)
 	for i := 0; i < rsize; i++ {
-		d := C.ngt_get_result(results, C.uint32_t(i), n.ebuf)
+		d := C.ngt_get_result(results, C.uint32_t(i), ebuf)
 		if d.id == 0 && d.distance == 0 {
-			result[i] = SearchResult{0, 0, newGoError(n.ebuf)}
+			result[i] = SearchResult{0, 0, newGoError(ebuf)}
 		} else {
 			result[i] = SearchResult{uint32(d.id), float32(d.distance), nil}
 		}
@@ -296,9 +556,35 @@ func (n *ngt) Search(vec []float64, size int, epsilon, radius float32) ([]Search
 // Insert returns NGT object id.
 // This only stores not indexing, you must call CreateIndex and SaveIndex.
 func (n *ngt) Insert(vec []float64) (uint, error) {
+	if len(vec) != int(n.dimension) {
+		return 0, errors.ErrInvalidDimensionSize(len(vec), int(n.dimension))
+	}
+
+	n.wmu.Lock()
 	n.mu.Lock()
 	id := C.ngt_insert_index(n.index, (*C.double)(&vec[0]), C.uint32_t(n.dimension), n.ebuf)
 	n.mu.Unlock()
+	n.wmu.Unlock()
+	if id == 0 {
+		return 0, newGoError(n.ebuf)
+	}
+
+	return uint(id), nil
+}
+
+// InsertFloat32 returns NGT object id.
+// The float32 vector is passed to NGT without conversion.
+// This only stores not indexing, you must call CreateIndex and SaveIndex.
+func (n *ngt) InsertFloat32(vec []float32) (uint, error) {
+	if len(vec) != int(n.dimension) {
+		return 0, errors.ErrInvalidDimensionSize(len(vec), int(n.dimension))
+	}
+
+	n.wmu.Lock()
+	n.mu.Lock()
+	id := C.ngt_insert_index_as_float(n.index, (*C.float)(&vec[0]), C.uint32_t(n.dimension), n.ebuf)
+	n.mu.Unlock()
+	n.wmu.Unlock()
 	if id == 0 {
 		return 0, newGoError(n.ebuf)
 	}
@@ -306,6 +592,22 @@ func (n *ngt) Insert(vec []float64) (uint, error) {
 	return uint(id), nil
 }
 
+// InsertUint8 returns NGT object id.
+// NGT C API has no uint8 entry point, so the vector is widened to float32 which represents every uint8 value exactly,
+// and NGT narrows it back to store the object as uint8 for Uint8 index.
+// This only stores not indexing, you must call CreateIndex and SaveIndex.
+func (n *ngt) InsertUint8(vec []uint8) (uint, error) {
+	return n.InsertFloat32(toFloat32(vec))
+}
+
+func toFloat32(vec []uint8) []float32 {
+	fv := make([]float32, len(vec))
+	for i, v := range vec {
+		fv[i] = float32(v)
+	}
+	return fv
+}
+
 // InsertCommit returns NGT object id.
 // This stores and indexes at the same time.
 func (n *ngt) InsertCommit(vec []float64, poolSize uint32) (uint, error) {
@@ -327,7 +629,7 @@ func (n *ngt) InsertCommit(vec []float64, poolSize uint32) (uint, error) {
 	return id, nil
 }
 
-// BulkInsert returns NGT object ids.
+// BulkInsert returns NGT object ids in the order of vecs, a failed insertion leaves 0 at its position.
 // This only stores not indexing, you must call CreateIndex and SaveIndex.
 func (n *ngt) BulkInsert(vecs [][]float64) ([]uint, []error) {
 	ids := make([]uint, 0, len(vecs))
@@ -335,23 +637,29 @@ func (n *ngt) BulkInsert(vecs [][]float64) ([]uint, []error) {
 
 	var id uint
 
+	n.wmu.Lock()
 	n.mu.Lock()
 	for _, vec := range vecs {
+		if len(vec) != int(n.dimension) {
+			errs = append(errs, errors.ErrInvalidDimensionSize(len(vec), int(n.dimension)))
+			ids = append(ids, 0)
+			continue
+		}
 		// n.mu.Lock()
 		id = uint(C.ngt_insert_index(n.index, (*C.double)(&vec[0]), C.uint32_t(n.dimension), n.ebuf))
 		// n.mu.Unlock()
 		if id == 0 {
 			errs = append(errs, newGoError(n.ebuf))
-		} else {
-			ids = append(ids, uint(id))
 		}
+		ids = append(ids, uint(id))
 	}
 	n.mu.Unlock()
+	n.wmu.Unlock()
 
 	return ids, errs
 }
 
-// BulkInsertCommit returns NGT object ids.
+// BulkInsertCommit returns NGT object ids in the order of vecs, a failed insertion leaves 0 at its position.
 // This stores and indexes at the same time.
 func (n *ngt) BulkInsertCommit(vecs [][]float64, poolSize uint32) ([]uint, []error) {
 	ids := make([]uint, 0, len(vecs))
@@ -362,8 +670,9 @@ func (n *ngt) BulkInsertCommit(vecs [][]float64, poolSize uint32) ([]uint, []err
 	var err error
 
 	for _, vec := range vecs {
-		if id, err = n.Insert(vec); err == nil {
-			ids = append(ids, id)
+		id, err = n.Insert(vec)
+		ids = append(ids, id)
+		if err == nil {
 			idx++
 			if idx >= n.bulkInsertChunkSize {
 				err = n.CreateAndSaveIndex(poolSize)
@@ -397,6 +706,13 @@ func (n *ngt) CreateAndSaveIndex(poolSize uint32) error {
 
 // CreateIndex creates NGT index.
 func (n *ngt) CreateIndex(poolSize uint32) error {
+	n.wmu.Lock()
+	defer n.wmu.Unlock()
+
+	if n.doubleBuffer {
+		return n.createShadowIndex(poolSize)
+	}
+
 	n.mu.Lock()
 	ret := C.ngt_create_index(n.index, C.uint32_t(poolSize), n.ebuf)
 	n.mu.Unlock()
@@ -407,6 +723,291 @@ func (n *ngt) CreateIndex(poolSize uint32) error {
 	return nil
 }
 
+// createShadowIndex builds the graph on a copy of the current index and swaps it in,
+// so that Search keeps being served by the last built index during the build.
+// It requires twice the index memory and extra time to copy the index.
+// The caller must hold wmu so that no mutation happens until the swap.
+func (n *ngt) createShadowIndex(poolSize uint32) error {
+	dir, err := ioutil.TempDir(filepath.Dir(n.idxPath), "ngt-shadow-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
+
+	path := C.CString(dir)
+	defer C.free(unsafe.Pointer(path))
+
+	n.mu.RLock()
+	ret := C.ngt_save_index(n.index, path, ebuf)
+	n.mu.RUnlock()
+	if ret == ErrorCode {
+		return newGoError(ebuf)
+	}
+
+	index := C.ngt_open_index(path, ebuf)
+	if index == nil {
+		return newGoError(ebuf)
+	}
+
+	if C.ngt_create_index(index, C.uint32_t(poolSize), ebuf) == ErrorCode {
+		err = newGoError(ebuf)
+		C.ngt_close_index(index)
+		return err
+	}
+
+	ospace := C.ngt_get_object_space(index, ebuf)
+	if ospace == nil {
+		err = newGoError(ebuf)
+		C.ngt_close_index(index)
+		return err
+	}
+
+	n.mu.Lock()
+	old := n.index
+	n.index = index
+	n.ospace = ospace
+	n.mu.Unlock()
+
+	C.ngt_close_index(old)
+
+	return nil
+}
+
+// SaveIndexTo stores NGT index to the path instead of the index path.
+// Search keeps being served while writes wait until it finishes.
+func (n *ngt) SaveIndexTo(path string) error {
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
+
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	n.mu.RLock()
+	ret := C.ngt_save_index(n.index, cpath, ebuf)
+	n.mu.RUnlock()
+
+	if ret == ErrorCode {
+		return newGoError(ebuf)
+	}
+
+	return nil
+}
+
+// Replace loads the index stored in the path and replaces the current index with it.
+// The index must have the same dimension and object type as the current index.
+// commit receives the number of the objects of the loaded index and exists, which fails when the object id is not stored in it,
+// so that the caller can check its own state against the index before the swap,
+// and swap, which replaces the index, so that the caller can replace its own state atomically with the index.
+// The loaded index is discarded when commit does not call swap.
+func (n *ngt) Replace(path string, commit func(objects uint64, exists func(id uint) error, swap func()) error) error {
+	n.wmu.Lock()
+	defer n.wmu.Unlock()
+
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
+
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	index := C.ngt_open_index(cpath, ebuf)
+	if index == nil {
+		return newGoError(ebuf)
+	}
+
+	err := n.checkProperty(index, ebuf)
+	if err != nil {
+		C.ngt_close_index(index)
+		return err
+	}
+
+	ospace := C.ngt_get_object_space(index, ebuf)
+	if ospace == nil {
+		err = newGoError(ebuf)
+		C.ngt_close_index(index)
+		return err
+	}
+
+	exists := func(id uint) error {
+		var ok bool
+		switch n.objectType {
+		case Float:
+			ok = C.ngt_get_object_as_float(ospace, C.ObjectID(id), ebuf) != nil
+		case Uint8:
+			ok = C.ngt_get_object_as_integer(ospace, C.ObjectID(id), ebuf) != nil
+		default:
+			return errors.ErrUnsupportedObjectType
+		}
+		if !ok {
+			return newGoError(ebuf)
+		}
+		return nil
+	}
+
+	objects := uint64(C.ngt_get_number_of_objects(index, ebuf))
+
+	var swapped bool
+	err = commit(objects, exists, func() {
+		n.mu.Lock()
+		old := n.index
+		n.index = index
+		n.ospace = ospace
+		n.mu.Unlock()
+
+		C.ngt_close_index(old)
+		swapped = true
+	})
+	if !swapped {
+		C.ngt_close_index(index)
+	}
+
+	return err
+}
+
+// checkProperty checks index has the same dimension and object type as the current index.
+func (n *ngt) checkProperty(index C.NGTIndex, ebuf C.NGTError) error {
+	prop := C.ngt_create_property(ebuf)
+	if prop == nil {
+		return errors.ErrCreateProperty(newGoError(ebuf))
+	}
+	defer C.ngt_destroy_property(prop)
+
+	if C.ngt_get_property(index, prop, ebuf) == ErrorCode {
+		return newGoError(ebuf)
+	}
+
+	if dim := C.ngt_get_property_dimension(prop, ebuf); dim != n.dimension {
+		return errors.ErrInvalidDimensionSize(int(dim), int(n.dimension))
+	}
+
+	ot := C.ngt_get_property_object_type(prop, ebuf)
+	if (n.objectType == Float && !bool(C.ngt_is_property_object_type_float(ot))) ||
+		(n.objectType == Uint8 && !bool(C.ngt_is_property_object_type_integer(ot))) {
+		return errors.ErrUnsupportedObjectType
+	}
+
+	return nil
+}
+
+// Rebuild builds a new index from the live objects into a fresh path to drop the removed objects left in the graph.
+// Writes are blocked during the build, while Search keeps being served by the current index.
+// commit receives the old to new object id mapping and swap, which replaces the index,
+// so that the caller can replace its own id mapping atomically with the index.
+// The new index is discarded when commit does not call swap.
+func (n *ngt) Rebuild(poolSize uint32, commit func(ids map[uint]uint, swap func()) error) error {
+	n.wmu.Lock()
+	defer n.wmu.Unlock()
+
+	dir, err := ioutil.TempDir(filepath.Dir(n.idxPath), "ngt-rebuild-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	ebuf := C.ngt_create_error_object()
+	defer C.ngt_destroy_error_object(ebuf)
+
+	path := C.CString(dir)
+	defer C.free(unsafe.Pointer(path))
+
+	prop := C.ngt_create_property(ebuf)
+	if prop == nil {
+		return errors.ErrCreateProperty(newGoError(ebuf))
+	}
+	defer C.ngt_destroy_property(prop)
+
+	n.mu.RLock()
+	if C.ngt_get_property(n.index, prop, ebuf) == ErrorCode {
+		n.mu.RUnlock()
+		return newGoError(ebuf)
+	}
+
+	index := C.ngt_create_graph_and_tree(path, prop, ebuf)
+	if index == nil {
+		n.mu.RUnlock()
+		return newGoError(ebuf)
+	}
+
+	ids, err := n.copyObjects(index, ebuf)
+	n.mu.RUnlock()
+	if err != nil {
+		C.ngt_close_index(index)
+		return err
+	}
+
+	if C.ngt_create_index(index, C.uint32_t(poolSize), ebuf) == ErrorCode {
+		err = newGoError(ebuf)
+		C.ngt_close_index(index)
+		return err
+	}
+
+	ospace := C.ngt_get_object_space(index, ebuf)
+	if ospace == nil {
+		err = newGoError(ebuf)
+		C.ngt_close_index(index)
+		return err
+	}
+
+	var swapped bool
+	err = commit(ids, func() {
+		n.mu.Lock()
+		old := n.index
+		n.index = index
+		n.ospace = ospace
+		n.mu.Unlock()
+
+		C.ngt_close_index(old)
+		swapped = true
+	})
+	if !swapped {
+		C.ngt_close_index(index)
+	}
+
+	return err
+}
+
+// copyObjects inserts the live objects of the current index into index in object id order
+// and returns the old to new object id mapping, it must be called while holding the read lock.
+func (n *ngt) copyObjects(index C.NGTIndex, ebuf C.NGTError) (map[uint]uint, error) {
+	var (
+		dimension = int(n.dimension)
+		vec       = make([]float32, dimension)
+		rsize     = uint(C.ngt_get_object_repository_size(n.index, ebuf))
+		ids       = make(map[uint]uint, int(C.ngt_get_number_of_objects(n.index, ebuf)))
+	)
+	for id := uint(1); id < rsize; id++ {
+		switch n.objectType {
+		case Float:
+			results := C.ngt_get_object_as_float(n.ospace, C.ObjectID(id), ebuf)
+			if results == nil {
+				C.ngt_clear_error_string(ebuf)
+				continue
+			}
+			copy(vec, (*[1 << 30]float32)(unsafe.Pointer(results))[:dimension:dimension])
+		case Uint8:
+			results := C.ngt_get_object_as_integer(n.ospace, C.ObjectID(id), ebuf)
+			if results == nil {
+				C.ngt_clear_error_string(ebuf)
+				continue
+			}
+			for i, v := range (*[1 << 30]C.uchar)(unsafe.Pointer(results))[:dimension:dimension] {
+				vec[i] = float32(v)
+			}
+		default:
+			return nil, errors.ErrUnsupportedObjectType
+		}
+
+		nid := C.ngt_insert_index_as_float(index, (*C.float)(&vec[0]), C.uint32_t(n.dimension), ebuf)
+		if nid == 0 {
+			return nil, newGoError(ebuf)
+		}
+		ids[id] = uint(nid)
+	}
+	return ids, nil
+}
+
 // SaveIndex stores NGT index to storage.
 func (n *ngt) SaveIndex() error {
 	n.mu.RLock()
@@ -422,9 +1023,11 @@ func (n *ngt) SaveIndex() error {
 
 // Remove removes from NGT index.
 func (n *ngt) Remove(id uint) error {
+	n.wmu.Lock()
 	n.mu.Lock()
 	ret := C.ngt_remove_index(n.index, C.ObjectID(id), n.ebuf)
 	n.mu.Unlock()
+	n.wmu.Unlock()
 	if ret == ErrorCode {
 		return newGoError(n.ebuf)
 	}
@@ -435,36 +1038,153 @@ func (n *ngt) Remove(id uint) error {
 // GetVector returns vector stored in NGT index.
 func (n *ngt) GetVector(id uint) ([]float64, error) {
 	dimension := int(n.dimension)
-	ret := make([]float64, 0, dimension)
+	ret := make([]float64, dimension)
 	switch n.objectType {
 	case Float:
 		n.mu.RLock()
 		results := C.ngt_get_object_as_float(n.ospace, C.ObjectID(id), n.ebuf)
-		n.mu.RUnlock()
 		if results == nil {
+			n.mu.RUnlock()
 			return nil, newGoError(n.ebuf)
 		}
 		slice := (*[1 << 30]C.float)(unsafe.Pointer(results))[:dimension:dimension]
 		for i := 0; i < dimension; i++ {
 			ret[i] = float64(slice[i])
 		}
+		n.mu.RUnlock()
 	case Uint8:
 		n.mu.RLock()
 		results := C.ngt_get_object_as_integer(n.ospace, C.ObjectID(id), n.ebuf)
-		n.mu.RUnlock()
 		if results == nil {
+			n.mu.RUnlock()
 			return nil, newGoError(n.ebuf)
 		}
 		slice := (*[1 << 30]C.uchar)(unsafe.Pointer(results))[:dimension:dimension]
 		for i := 0; i < dimension; i++ {
 			ret[i] = float64(slice[i])
 		}
+		n.mu.RUnlock()
+	default:
+		return nil, errors.ErrUnsupportedObjectType
+	}
+	return ret, nil
+}
+
+// GetVectorFloat32 returns vector stored in NGT index as float32.
+// The vector of Float index is copied without conversion.
+func (n *ngt) GetVectorFloat32(id uint) ([]float32, error) {
+	dimension := int(n.dimension)
+	ret := make([]float32, dimension)
+	switch n.objectType {
+	case Float:
+		n.mu.RLock()
+		results := C.ngt_get_object_as_float(n.ospace, C.ObjectID(id), n.ebuf)
+		if results == nil {
+			n.mu.RUnlock()
+			return nil, newGoError(n.ebuf)
+		}
+		copy(ret, (*[1 << 30]float32)(unsafe.Pointer(results))[:dimension:dimension])
+		n.mu.RUnlock()
+	case Uint8:
+		n.mu.RLock()
+		results := C.ngt_get_object_as_integer(n.ospace, C.ObjectID(id), n.ebuf)
+		if results == nil {
+			n.mu.RUnlock()
+			return nil, newGoError(n.ebuf)
+		}
+		slice := (*[1 << 30]C.uchar)(unsafe.Pointer(results))[:dimension:dimension]
+		for i := 0; i < dimension; i++ {
+			ret[i] = float32(slice[i])
+		}
+		n.mu.RUnlock()
 	default:
 		return nil, errors.ErrUnsupportedObjectType
 	}
 	return ret, nil
 }
 
+// Property returns the property of NGT index.
+func (n *ngt) Property() (*Property, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	prop := C.ngt_create_property(n.ebuf)
+	if prop == nil {
+		return nil, errors.ErrCreateProperty(newGoError(n.ebuf))
+	}
+	defer C.ngt_destroy_property(prop)
+
+	if C.ngt_get_property(n.index, prop, n.ebuf) == ErrorCode {
+		return nil, newGoError(n.ebuf)
+	}
+
+	var ot objectType
+	switch t := C.ngt_get_property_object_type(prop, n.ebuf); {
+	case bool(C.ngt_is_property_object_type_float(t)):
+		ot = Float
+	case bool(C.ngt_is_property_object_type_integer(t)):
+		ot = Uint8
+	}
+
+	dt := toDistanceType(C.ngt_get_property_distance_type(prop, n.ebuf))
+
+	return &Property{
+		Dimension:        int(C.ngt_get_property_dimension(prop, n.ebuf)),
+		ObjectType:       ot.String(),
+		DistanceType:     dt.String(),
+		CreationEdgeSize: int(C.ngt_get_property_edge_size_for_creation(prop, n.ebuf)),
+		SearchEdgeSize:   int(C.ngt_get_property_edge_size_for_search(prop, n.ebuf)),
+	}, nil
+}
+
+// toDistanceType converts NGT::ObjectSpace::DistanceType to distanceType.
+func toDistanceType(t C.int32_t) distanceType {
+	switch t {
+	case 0:
+		return L1
+	case 1:
+		return L2
+	case 2:
+		return Hamming
+	case 3:
+		return Angle
+	case 4:
+		return Cosine
+	case 5:
+		return NormalizedAngle
+	case 6:
+		return NormalizedCosine
+	}
+	return DistanceNone
+}
+
+// ObjectCount returns the number of objects stored in NGT index including not indexed ones.
+func (n *ngt) ObjectCount() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return uint64(C.ngt_get_number_of_objects(n.index, n.ebuf))
+}
+
+// IndexedCount returns the number of objects indexed by CreateIndex.
+func (n *ngt) IndexedCount() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return uint64(C.ngt_get_number_of_indexed_objects(n.index, n.ebuf))
+}
+
+// RemovedCount returns the number of removed objects whose slots are not reused yet.
+func (n *ngt) RemovedCount() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	// the slot of object id 0 is reserved by NGT
+	size := uint64(C.ngt_get_object_repository_size(n.index, n.ebuf))
+	cnt := uint64(C.ngt_get_number_of_objects(n.index, n.ebuf))
+	if size <= cnt+1 {
+		return 0
+	}
+	return size - cnt - 1
+}
+
 func (n *ngt) refreshEbufIfError(err error) {
 	if err != nil {
 		C.ngt_destroy_error_object(n.ebuf)
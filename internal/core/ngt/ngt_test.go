@@ -25,6 +25,7 @@ package ngt
 
 import (
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"os/exec"
 	"path"
@@ -229,6 +230,36 @@ func TestBulkInsertCommit(t *testing.T) {
 	}
 }
 
+func TestBulkInsertCommitKeepsOrder(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestBulkInsertCommitKeepsOrder(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(tmpdir),
+		WithObjectType(Float),
+		WithDimension(3),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBulkInsertCommitKeepsOrder(%v)", err)
+	}
+	defer ngt.Close()
+
+	ids, errs := ngt.BulkInsertCommit([][]float64{
+		{1, 0, 0},
+		{0, 1},
+		{0, 0, 1},
+	}, 2)
+	if len(errs) != 1 {
+		t.Errorf("TestBulkInsertCommitKeepsOrder: %d errors, wanted: 1", len(errs))
+	}
+	if wants := []uint{1, 0, 2}; !reflect.DeepEqual(ids, wants) {
+		t.Errorf("TestBulkInsertCommitKeepsOrder: %v, wanted: %v", ids, wants)
+	}
+}
+
 func TestSearch(t *testing.T) {
 	tests := []struct {
 		vector []float64
@@ -263,6 +294,49 @@ func TestSearch(t *testing.T) {
 	}
 }
 
+func TestBulkSearch(t *testing.T) {
+	vecs := [][]float64{
+		{1, 0, 0, 0, 0, 0},
+		{0, 1, 0, 0, 0, 0},
+		{0, 0, 1, 0, 0, 0},
+		{1, 0},
+		{0, 0, 0, 1, 0, 0},
+		{0, 0, 0, 0, 1, 0},
+		{1, 1, 0, 0, 0, 0},
+	}
+	want := []uint32{1, 2, 3, 0, 4, 5, 6}
+
+	ngt, err := Load(
+		WithIndexPath(index),
+		WithObjectType(Uint8),
+		WithDimension(6),
+		WithBulkSearchPoolSize(3),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestBulkSearch(%v)", err)
+	}
+
+	results, errs := ngt.BulkSearch(vecs, 1, 0.1, -1.0)
+	if len(results) != len(vecs) || len(errs) != len(vecs) {
+		t.Fatalf("TestBulkSearch: got %d results and %d errors, wanted: %d", len(results), len(errs), len(vecs))
+	}
+	for i, vec := range vecs {
+		if want[i] == 0 {
+			if errs[i] == nil {
+				t.Errorf("TestBulkSearch(%v): invalid dimension vector was searched", vec)
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("Unexpected error: TestBulkSearch(%v)", errs[i])
+		}
+		if len(results[i]) == 0 || results[i][0].ID != want[i] {
+			t.Errorf("TestBulkSearch(%v): %v, wanted: %v", vec, results[i], want[i])
+		}
+	}
+}
+
 func TestRemove(t *testing.T) {
 	tests := []struct {
 		id   uint
@@ -330,3 +404,512 @@ func TestGetVector(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateIndexWithDoubleBuffer(t *testing.T) {
+	vectors := [][]float64{
+		{1, 0, 0, 0, 0, 0},
+		{0, 1, 0, 0, 0, 0},
+		{0, 0, 1, 0, 0, 0},
+	}
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestCreateIndexWithDoubleBuffer(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(path.Join(tmpdir, "index")),
+		WithObjectType(Uint8),
+		WithDimension(6),
+		WithDoubleBuffer(true),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestCreateIndexWithDoubleBuffer(%v)", err)
+	}
+
+	if _, errs := ngt.BulkInsert(vectors); len(errs) > 0 {
+		t.Errorf("Unexpected error: TestCreateIndexWithDoubleBuffer(%v)", errs)
+	}
+
+	if err := ngt.CreateIndex(poolSize); err != nil {
+		t.Errorf("Unexpected error: TestCreateIndexWithDoubleBuffer(%v)", err)
+	}
+
+	for i, vec := range vectors {
+		result, err := ngt.Search(vec, 1, 0.1, -1.0)
+		if err != nil {
+			t.Errorf("Unexpected error: TestCreateIndexWithDoubleBuffer(%v)", err)
+		}
+		if len(result) == 0 || result[0].ID != uint32(i+1) {
+			t.Errorf("TestCreateIndexWithDoubleBuffer(%v): %v, wanted: %v", vec, result, i+1)
+		}
+		got, err := ngt.GetVector(uint(i + 1))
+		if err != nil {
+			t.Errorf("Unexpected error: TestCreateIndexWithDoubleBuffer(%v)", err)
+		}
+		if !reflect.DeepEqual(got, vec) {
+			t.Errorf("TestCreateIndexWithDoubleBuffer(%v): %v, wanted: %v", i+1, got, vec)
+		}
+	}
+}
+
+func TestSearchDuringCreateIndexWithDoubleBuffer(t *testing.T) {
+	const (
+		dim   = 64
+		count = 20000
+	)
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(path.Join(tmpdir, "index")),
+		WithObjectType(Float),
+		WithDimension(dim),
+		WithDoubleBuffer(true),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", err)
+	}
+	defer ngt.Close()
+
+	query := make([]float64, dim)
+	query[0] = 1
+	if _, err := ngt.Insert(query); err != nil {
+		t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", err)
+	}
+	if err := ngt.CreateIndex(poolSize); err != nil {
+		t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	vecs := make([][]float64, count)
+	for i := range vecs {
+		vecs[i] = make([]float64, dim)
+		for j := range vecs[i] {
+			vecs[i][j] = r.Float64()
+		}
+	}
+	if _, errs := ngt.BulkInsert(vecs); len(errs) > 0 {
+		t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", errs)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- ngt.CreateIndex(poolSize)
+	}()
+
+	// searches must be served by the last built index instead of waiting for the build
+	var served int
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", err)
+			}
+			if served == 0 {
+				t.Error("TestSearchDuringCreateIndexWithDoubleBuffer: no search returned before CreateIndex finished")
+			}
+			return
+		default:
+		}
+
+		result, err := ngt.Search(query, 1, 0.1, -1.0)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestSearchDuringCreateIndexWithDoubleBuffer(%v)", err)
+		}
+		if len(result) == 0 {
+			t.Fatal("TestSearchDuringCreateIndexWithDoubleBuffer: empty search result")
+		}
+
+		select {
+		case err := <-done:
+			done <- err
+		default:
+			served++
+		}
+	}
+}
+
+func TestInsertFloat32(t *testing.T) {
+	tests := []struct {
+		vector []float32
+		want   uint
+	}{
+		{[]float32{1, 0, 0, 0, 0, 0}, 1},
+		{[]float32{0, 1, 0, 0, 0, 0}, 2},
+		{[]float32{0, 0, 1, 0, 0, 0}, 3},
+	}
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestInsertFloat32(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(tmpdir),
+		WithObjectType(Float),
+		WithDimension(6),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestInsertFloat32(%v)", err)
+	}
+
+	for _, tt := range tests {
+		id, err := ngt.InsertFloat32(tt.vector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != tt.want {
+			t.Errorf("TestInsertFloat32(%v): %v, wanted: %v", tt.vector, id, tt.want)
+		}
+	}
+
+	if _, err := ngt.InsertFloat32([]float32{1, 0}); err == nil {
+		t.Error("TestInsertFloat32: invalid dimension vector was inserted")
+	}
+
+	if err := ngt.CreateIndex(poolSize); err != nil {
+		t.Errorf("Unexpected error: TestInsertFloat32(%v)", err)
+	}
+
+	for _, tt := range tests {
+		result, err := ngt.SearchFloat32(tt.vector, 1, 0.1, -1.0)
+		if err != nil {
+			t.Errorf("Unexpected error: TestInsertFloat32(%v)", err)
+		}
+		if len(result) == 0 || result[0].ID != uint32(tt.want) {
+			t.Errorf("TestInsertFloat32(%v): %v, wanted: %v", tt.vector, result, tt.want)
+		}
+		vec, err := ngt.GetVectorFloat32(tt.want)
+		if err != nil {
+			t.Errorf("Unexpected error: TestInsertFloat32(%v)", err)
+		}
+		if !reflect.DeepEqual(vec, tt.vector) {
+			t.Errorf("TestInsertFloat32(%v): %v, wanted: %v", tt.want, vec, tt.vector)
+		}
+	}
+}
+
+func TestInsertUint8(t *testing.T) {
+	tests := []struct {
+		vector []uint8
+		want   uint
+	}{
+		{[]uint8{255, 0, 0, 0, 0, 0}, 1},
+		{[]uint8{0, 255, 0, 0, 0, 0}, 2},
+		{[]uint8{0, 0, 255, 0, 0, 0}, 3},
+	}
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestInsertUint8(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(tmpdir),
+		WithObjectType(Uint8),
+		WithDimension(6),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestInsertUint8(%v)", err)
+	}
+
+	for _, tt := range tests {
+		id, err := ngt.InsertUint8(tt.vector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != tt.want {
+			t.Errorf("TestInsertUint8(%v): %v, wanted: %v", tt.vector, id, tt.want)
+		}
+	}
+
+	if err := ngt.CreateIndex(poolSize); err != nil {
+		t.Errorf("Unexpected error: TestInsertUint8(%v)", err)
+	}
+
+	for _, tt := range tests {
+		result, err := ngt.SearchUint8(tt.vector, 1, 0.1, -1.0)
+		if err != nil {
+			t.Errorf("Unexpected error: TestInsertUint8(%v)", err)
+		}
+		if len(result) == 0 || result[0].ID != uint32(tt.want) {
+			t.Errorf("TestInsertUint8(%v): %v, wanted: %v", tt.vector, result, tt.want)
+		}
+	}
+}
+
+func TestIndexInfo(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestIndexInfo(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(tmpdir),
+		WithObjectType(Float),
+		WithDistanceType(L2),
+		WithDimension(6),
+		WithCreationEdgeSize(20),
+		WithSearchEdgeSize(10),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestIndexInfo(%v)", err)
+	}
+
+	want := &Property{
+		Dimension:        6,
+		ObjectType:       "Float",
+		DistanceType:     "L2",
+		CreationEdgeSize: 20,
+		SearchEdgeSize:   10,
+	}
+	prop, err := ngt.Property()
+	if err != nil {
+		t.Errorf("Unexpected error: TestIndexInfo(%v)", err)
+	}
+	if !reflect.DeepEqual(prop, want) {
+		t.Errorf("TestIndexInfo: %v, wanted: %v", prop, want)
+	}
+
+	ids, _ := ngt.BulkInsert([][]float64{
+		{1, 0, 0, 0, 0, 0},
+		{0, 1, 0, 0, 0, 0},
+		{0, 0, 1, 0, 0, 0},
+	})
+	if err := ngt.CreateIndex(poolSize); err != nil {
+		t.Errorf("Unexpected error: TestIndexInfo(%v)", err)
+	}
+	if _, err := ngt.Insert([]float64{0, 0, 0, 1, 0, 0}); err != nil {
+		t.Errorf("Unexpected error: TestIndexInfo(%v)", err)
+	}
+	if err := ngt.Remove(ids[0]); err != nil {
+		t.Errorf("Unexpected error: TestIndexInfo(%v)", err)
+	}
+
+	if cnt := ngt.ObjectCount(); cnt != 3 {
+		t.Errorf("TestIndexInfo ObjectCount: %v, wanted: %v", cnt, 3)
+	}
+	if cnt := ngt.IndexedCount(); cnt != 2 {
+		t.Errorf("TestIndexInfo IndexedCount: %v, wanted: %v", cnt, 2)
+	}
+	if cnt := ngt.RemovedCount(); cnt != 1 {
+		t.Errorf("TestIndexInfo RemovedCount: %v, wanted: %v", cnt, 1)
+	}
+}
+
+func TestExactSearch(t *testing.T) {
+	tests := []struct {
+		vector []float64
+		want   []uint32
+	}{
+		{[]float64{1, 0, 0, 0, 0, 0}, []uint32{1, 4}},
+		{[]float64{0, 1, 0, 0, 0, 0}, []uint32{2, 4}},
+		{[]float64{0.1, 0, 1, 0, 0, 0}, []uint32{3, 1}},
+		{[]float64{1, 0.8, 0, 0, 0, 0}, []uint32{4, 1}},
+	}
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestExactSearch(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(tmpdir),
+		WithObjectType(Float),
+		WithDistanceType(L2),
+		WithDimension(6),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestExactSearch(%v)", err)
+	}
+
+	ids, _ := ngt.BulkInsert([][]float64{
+		{1, 0, 0, 0, 0, 0},
+		{0, 1, 0, 0, 0, 0},
+		{0, 0, 1, 0, 0, 0},
+		{1, 1, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 1},
+	})
+	// removed objects must not appear in the results
+	if err := ngt.Remove(ids[4]); err != nil {
+		t.Errorf("Unexpected error: TestExactSearch(%v)", err)
+	}
+
+	for _, tt := range tests {
+		results, err := ngt.ExactSearch(tt.vector, 2)
+		if err != nil {
+			t.Errorf("Unexpected error: TestExactSearch(%v)", err)
+		}
+		got := make([]uint32, 0, len(results))
+		for _, r := range results {
+			got = append(got, r.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("TestExactSearch(%v): %v, wanted: %v", tt.vector, got, tt.want)
+		}
+	}
+}
+
+func TestRebuild(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestRebuild(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	ngt, err := New(
+		WithIndexPath(path.Join(tmpdir, "index")),
+		WithObjectType(Float),
+		WithDimension(6),
+	)
+	defer ngt.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestRebuild(%v)", err)
+	}
+
+	vecs := [][]float64{
+		{1, 0, 0, 0, 0, 0},
+		{0, 1, 0, 0, 0, 0},
+		{0, 0, 1, 0, 0, 0},
+		{0, 0, 0, 1, 0, 0},
+	}
+	ids, _ := ngt.BulkInsert(vecs)
+	if err := ngt.CreateIndex(poolSize); err != nil {
+		t.Errorf("Unexpected error: TestRebuild(%v)", err)
+	}
+	for _, id := range []uint{ids[0], ids[2]} {
+		if err := ngt.Remove(id); err != nil {
+			t.Errorf("Unexpected error: TestRebuild(%v)", err)
+		}
+	}
+
+	var remap map[uint]uint
+	err = ngt.Rebuild(poolSize, func(m map[uint]uint, swap func()) error {
+		remap = m
+		swap()
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: TestRebuild(%v)", err)
+	}
+
+	want := map[uint]uint{ids[1]: 1, ids[3]: 2}
+	if !reflect.DeepEqual(remap, want) {
+		t.Errorf("TestRebuild: %v, wanted: %v", remap, want)
+	}
+	if cnt := ngt.RemovedCount(); cnt != 0 {
+		t.Errorf("TestRebuild RemovedCount: %v, wanted: %v", cnt, 0)
+	}
+	for old, id := range want {
+		vec, err := ngt.GetVector(id)
+		if err != nil {
+			t.Errorf("Unexpected error: TestRebuild(%v)", err)
+		}
+		if !reflect.DeepEqual(vec, vecs[old-1]) {
+			t.Errorf("TestRebuild(%v): %v, wanted: %v", id, vec, vecs[old-1])
+		}
+		result, err := ngt.Search(vec, 1, 0.1, -1.0)
+		if err != nil {
+			t.Errorf("Unexpected error: TestRebuild(%v)", err)
+		}
+		if len(result) == 0 || result[0].ID != uint32(id) {
+			t.Errorf("TestRebuild(%v): %v, wanted: %v", vec, result, id)
+		}
+	}
+}
+
+func TestSaveIndexToAndReplace(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	src, err := New(
+		WithIndexPath(path.Join(tmpdir, "src")),
+		WithObjectType(Float),
+		WithDimension(6),
+	)
+	defer src.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+	want := []float64{1, 0, 0, 0, 0, 0}
+	if _, err := src.InsertCommit(want, poolSize); err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+	snapshot := path.Join(tmpdir, "snapshot")
+	if err := src.SaveIndexTo(snapshot); err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+
+	dst, err := New(
+		WithIndexPath(path.Join(tmpdir, "dst")),
+		WithObjectType(Float),
+		WithDimension(6),
+	)
+	defer dst.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+	if _, err := dst.InsertCommit([]float64{0, 1, 0, 0, 0, 0}, poolSize); err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+
+	err = dst.Replace(snapshot, func(objects uint64, exists func(id uint) error, swap func()) error {
+		if objects != 1 {
+			t.Errorf("TestSaveIndexToAndReplace: %d objects, wanted: 1", objects)
+		}
+		if err := exists(1); err != nil {
+			return err
+		}
+		if err := exists(2); err == nil {
+			t.Errorf("TestSaveIndexToAndReplace: object 2 exists, wanted not found in the snapshot")
+		}
+		swap()
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+
+	vec, err := dst.GetVector(1)
+	if err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+	if !reflect.DeepEqual(vec, want) {
+		t.Errorf("TestSaveIndexToAndReplace: %v, wanted: %v", vec, want)
+	}
+
+	other, err := New(
+		WithIndexPath(path.Join(tmpdir, "other")),
+		WithObjectType(Float),
+		WithDimension(4),
+	)
+	defer other.Close()
+	if err != nil {
+		t.Errorf("Unexpected error: TestSaveIndexToAndReplace(%v)", err)
+	}
+	err = other.Replace(snapshot, func(_ uint64, _ func(id uint) error, swap func()) error {
+		swap()
+		return nil
+	})
+	if err == nil {
+		t.Error("TestSaveIndexToAndReplace: index of different dimension was replaced")
+	}
+}
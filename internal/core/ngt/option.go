@@ -29,6 +29,7 @@ package ngt
 */
 import "C"
 import (
+	"runtime"
 	"strings"
 	"time"
 
@@ -46,6 +47,7 @@ var (
 		WithObjectType(Float),
 		WithDistanceType(L2),
 		WithBulkInsertChunkSize(100),
+		WithBulkSearchPoolSize(runtime.NumCPU()),
 	}
 )
 
@@ -63,6 +65,26 @@ func WithBulkInsertChunkSize(size int) Option {
 	}
 }
 
+// WithDoubleBuffer enables building the index on a copy of the current index,
+// so Search is not blocked by CreateIndex at the cost of twice the index memory
+// and the time to copy the index.
+func WithDoubleBuffer(flg bool) Option {
+	return func(n *ngt) error {
+		n.doubleBuffer = flg
+		return nil
+	}
+}
+
+func WithBulkSearchPoolSize(size int) Option {
+	return func(n *ngt) error {
+		if size <= 0 {
+			return nil
+		}
+		n.bulkSearchPoolSize = size
+		return nil
+	}
+}
+
 func WithDimension(size int) Option {
 	return func(n *ngt) error {
 		if C.ngt_set_property_dimension(n.prop, C.int32_t(size), n.ebuf) == ErrorCode {
@@ -126,6 +148,7 @@ func WithDistanceType(t distanceType) Option {
 		default:
 			return errors.ErrUnsupportedDistanceType
 		}
+		n.distanceType = t
 		return nil
 	}
 }
@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dataset provides readers of the vector dataset files used to pre-fill an index
+package dataset
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// csvReader reads a csv file with one vector per line, one column may hold the id.
+type csvReader struct {
+	path   string
+	f      *os.File
+	r      *csv.Reader
+	idCol  int
+	header bool
+	n      int
+}
+
+func openCSV(path string, idCol int, header bool) (Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r := csv.NewReader(f)
+	r.ReuseRecord = true
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+	return &csvReader{
+		path:   path,
+		f:      f,
+		r:      r,
+		idCol:  idCol,
+		header: header,
+	}, nil
+}
+
+func (c *csvReader) Read() (string, []float64, error) {
+	if c.header {
+		c.header = false
+		_, err := c.r.Read()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	rec, err := c.r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return "", nil, err
+		}
+		return "", nil, errors.ErrInvalidDatasetRecord(err, c.path, c.n)
+	}
+
+	var id string
+	if c.idCol > 0 {
+		if c.idCol > len(rec) {
+			return "", nil, errors.ErrInvalidDatasetRecord(errors.ErrDatasetIDColumnNotFound(c.idCol), c.path, c.n)
+		}
+		id = strings.TrimSpace(rec[c.idCol-1])
+	}
+
+	vec := make([]float64, 0, len(rec))
+	for i, col := range rec {
+		if i == c.idCol-1 {
+			continue
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(col), 64)
+		if err != nil {
+			return "", nil, errors.ErrInvalidDatasetRecord(err, c.path, c.n)
+		}
+		vec = append(vec, f)
+	}
+	c.n++
+	return id, vec, nil
+}
+
+func (c *csvReader) Close() error {
+	return c.f.Close()
+}
@@ -0,0 +1,128 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dataset provides readers of the vector dataset files used to pre-fill an index
+package dataset
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Reader reads the vectors of a dataset file in order.
+type Reader interface {
+	// Read returns the next id and vector, or io.EOF after the last one.
+	Read() (id string, vec []float64, err error)
+	Close() error
+}
+
+// OpenFunc opens the named vector set of a dataset file.
+type OpenFunc func(path, name string) (Reader, error)
+
+var (
+	mu      sync.RWMutex
+	openers = make(map[string]OpenFunc)
+)
+
+// Register makes the format readable by Open,
+// it lets the formats depending on C libraries such as hdf5 live in their own package.
+func Register(format string, open OpenFunc) {
+	mu.Lock()
+	openers[format] = open
+	mu.Unlock()
+}
+
+// Open opens the dataset file, the records without an id get the id prefix followed by their 0-based position.
+func Open(path string, opts ...Option) (Reader, error) {
+	c := new(config)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(c)
+	}
+
+	format := strings.ToLower(c.format)
+	if format == "" {
+		format = formatOf(path)
+	}
+
+	var (
+		r   Reader
+		err error
+	)
+	switch format {
+	case "fvecs":
+		r, err = openVecs(path, 4, float32Elem)
+	case "ivecs":
+		r, err = openVecs(path, 4, int32Elem)
+	case "bvecs":
+		r, err = openVecs(path, 1, uint8Elem)
+	case "csv":
+		r, err = openCSV(path, c.idColumn, c.header)
+	default:
+		mu.RLock()
+		open, ok := openers[format]
+		mu.RUnlock()
+		if !ok {
+			return nil, errors.ErrUnsupportedDatasetFormat(format)
+		}
+		r, err = open(path, c.hdf5Dataset)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &sequencer{
+		Reader: r,
+		prefix: c.idPrefix,
+	}, nil
+}
+
+func formatOf(path string) string {
+	switch ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")); ext {
+	case "h5", "hdf", "hdf5":
+		return "hdf5"
+	default:
+		return ext
+	}
+}
+
+// sequencer assigns the ids from the position to the records without an id.
+type sequencer struct {
+	Reader
+	prefix string
+	n      int
+}
+
+func (s *sequencer) Read() (string, []float64, error) {
+	id, vec, err := s.Reader.Read()
+	if err != nil {
+		return "", nil, err
+	}
+	if id == "" {
+		id = s.prefix + strconv.Itoa(s.n)
+	}
+	s.n++
+	return id, vec, nil
+}
@@ -0,0 +1,239 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dataset provides readers of the vector dataset files used to pre-fill an index
+package dataset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func readAll(t *testing.T, path string, opts ...Option) ([]string, [][]float64) {
+	r, err := Open(path, opts...)
+	if err != nil {
+		t.Fatalf("Unexpected error: Open(%v)", err)
+	}
+	defer r.Close()
+
+	var (
+		ids  []string
+		vecs [][]float64
+	)
+	for {
+		id, vec, err := r.Read()
+		if err == io.EOF {
+			return ids, vecs
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: Read(%v)", err)
+		}
+		ids = append(ids, id)
+		vecs = append(vecs, vec)
+	}
+}
+
+func writeVecs(t *testing.T, path string, vecs [][]float64, elem func(*bytes.Buffer, float64)) {
+	buf := new(bytes.Buffer)
+	for _, vec := range vecs {
+		binary.Write(buf, binary.LittleEndian, int32(len(vec)))
+		for _, v := range vec {
+			elem(buf, v)
+		}
+	}
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatalf("Unexpected error: WriteFile(%v)", err)
+	}
+}
+
+func TestVecs(t *testing.T) {
+	vecs := [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	tests := []struct {
+		name string
+		elem func(*bytes.Buffer, float64)
+	}{
+		{
+			"test.fvecs",
+			func(b *bytes.Buffer, v float64) {
+				binary.Write(b, binary.LittleEndian, math.Float32bits(float32(v)))
+			},
+		},
+		{
+			"test.ivecs",
+			func(b *bytes.Buffer, v float64) {
+				binary.Write(b, binary.LittleEndian, int32(v))
+			},
+		},
+		{
+			"test.bvecs",
+			func(b *bytes.Buffer, v float64) {
+				b.WriteByte(uint8(v))
+			},
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestVecs(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.name)
+		writeVecs(t, path, vecs, tt.elem)
+
+		ids, got := readAll(t, path, WithIDPrefix("v"))
+		if !reflect.DeepEqual(got, vecs) {
+			t.Errorf("TestVecs(%s): %v, wanted: %v", tt.name, got, vecs)
+		}
+		if wants := []string{"v0", "v1"}; !reflect.DeepEqual(ids, wants) {
+			t.Errorf("TestVecs(%s): %v, wanted: %v", tt.name, ids, wants)
+		}
+	}
+}
+
+func TestVecsTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestVecsTruncated(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.bvecs")
+	if err := ioutil.WriteFile(path, []byte{3, 0, 0, 0, 1, 2}, 0600); err != nil {
+		t.Fatalf("Unexpected error: TestVecsTruncated(%v)", err)
+	}
+
+	r, err := Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestVecsTruncated(%v)", err)
+	}
+	defer r.Close()
+	if _, _, err := r.Read(); err == nil || err == io.EOF {
+		t.Errorf("TestVecsTruncated: %v, wanted: invalid record error", err)
+	}
+}
+
+func TestVecsInvalidDimension(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestVecsInvalidDimension(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			"too_large.bvecs",
+			[]byte{0, 0, 0, 0x7f},
+		},
+		{
+			"mismatch.bvecs",
+			[]byte{2, 0, 0, 0, 1, 2, 3, 0, 0, 0, 1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.name)
+		if err := ioutil.WriteFile(path, tt.data, 0600); err != nil {
+			t.Fatalf("Unexpected error: TestVecsInvalidDimension(%v)", err)
+		}
+
+		r, err := Open(path)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestVecsInvalidDimension(%v)", err)
+		}
+		for err == nil {
+			_, _, err = r.Read()
+		}
+		r.Close()
+		if err == io.EOF {
+			t.Errorf("TestVecsInvalidDimension(%s): %v, wanted: invalid record error", tt.name, err)
+		}
+	}
+}
+
+func TestCSV(t *testing.T) {
+	tests := []struct {
+		body  string
+		opts  []Option
+		ids   []string
+		wants [][]float64
+	}{
+		{
+			"1,2,3\n4,5,6\n",
+			nil,
+			[]string{"0", "1"},
+			[][]float64{{1, 2, 3}, {4, 5, 6}},
+		},
+		{
+			"id,x,y\na, 1, 2\nb, 3, 4\n",
+			[]Option{WithIDColumn(1), WithCSVHeader(true)},
+			[]string{"a", "b"},
+			[][]float64{{1, 2}, {3, 4}},
+		},
+		{
+			"1,2,x\n3,4,y\n",
+			[]Option{WithIDColumn(3)},
+			[]string{"x", "y"},
+			[][]float64{{1, 2}, {3, 4}},
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestCSV(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tt := range tests {
+		path := filepath.Join(dir, "test.csv")
+		if err := ioutil.WriteFile(path, []byte(tt.body), 0600); err != nil {
+			t.Fatalf("Unexpected error: TestCSV(%v)", err)
+		}
+
+		ids, got := readAll(t, path, tt.opts...)
+		if !reflect.DeepEqual(got, tt.wants) {
+			t.Errorf("TestCSV(%q): %v, wanted: %v", tt.body, got, tt.wants)
+		}
+		if !reflect.DeepEqual(ids, tt.ids) {
+			t.Errorf("TestCSV(%q): %v, wanted: %v", tt.body, ids, tt.ids)
+		}
+	}
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	if _, err := Open("test.unknown"); err == nil {
+		t.Error("TestUnsupportedFormat: nil, wanted: unsupported format error")
+	}
+}
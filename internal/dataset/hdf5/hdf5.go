@@ -0,0 +1,97 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package hdf5 registers the ANN-benchmarks hdf5 files to the dataset readers
+package hdf5
+
+import (
+	"io"
+
+	"github.com/vdaas/vald/internal/dataset"
+	"github.com/vdaas/vald/internal/errors"
+	"gonum.org/v1/hdf5"
+)
+
+func init() {
+	dataset.Register("hdf5", Open)
+}
+
+// reader serves the vectors of an hdf5 dataset loaded into memory at once.
+type reader struct {
+	data []float32
+	dim  int
+	n    int
+}
+
+// Open loads the named two dimensional float32 dataset such as train of the ANN-benchmarks files.
+func Open(path, name string) (dataset.Reader, error) {
+	f, err := hdf5.OpenFile(path, hdf5.F_ACC_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dset, err := f.OpenDataset(name)
+	if err != nil {
+		return nil, err
+	}
+	defer dset.Close()
+
+	space := dset.Space()
+	defer space.Close()
+
+	dims, _, err := space.SimpleExtentDims()
+	if err != nil {
+		return nil, err
+	}
+	if len(dims) != 2 || dims[1] == 0 {
+		return nil, errors.ErrInvalidDatasetShape(name, dims)
+	}
+
+	data := make([]float32, space.SimpleExtentNPoints())
+	err = dset.Read(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reader{
+		data: data,
+		dim:  int(dims[1]),
+	}, nil
+}
+
+func (r *reader) Read() (string, []float64, error) {
+	if (r.n+1)*r.dim > len(r.data) {
+		return "", nil, io.EOF
+	}
+	vec := make([]float64, r.dim)
+	for i, v := range r.data[r.n*r.dim : (r.n+1)*r.dim] {
+		vec[i] = float64(v)
+	}
+	r.n++
+	return "", vec, nil
+}
+
+func (r *reader) Close() error {
+	r.data = nil
+	return nil
+}
@@ -0,0 +1,79 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dataset provides readers of the vector dataset files used to pre-fill an index
+package dataset
+
+type Option func(*config)
+
+var (
+	defaultOpts = []Option{
+		WithHDF5Dataset("train"),
+	}
+)
+
+type config struct {
+	format      string
+	idColumn    int
+	idPrefix    string
+	header      bool
+	hdf5Dataset string
+}
+
+// WithFormat sets the file format fvecs, bvecs, ivecs, csv or hdf5, the extension of the path is used when it is empty
+func WithFormat(format string) Option {
+	return func(c *config) {
+		c.format = format
+	}
+}
+
+// WithIDColumn sets the 1-based csv column holding the ids, 0 assigns the ids from the sequence
+func WithIDColumn(col int) Option {
+	return func(c *config) {
+		if col >= 0 {
+			c.idColumn = col
+		}
+	}
+}
+
+// WithIDPrefix sets the prefix of the ids assigned from the sequence
+func WithIDPrefix(prefix string) Option {
+	return func(c *config) {
+		c.idPrefix = prefix
+	}
+}
+
+// WithCSVHeader sets whether the first csv line is a header to skip
+func WithCSVHeader(header bool) Option {
+	return func(c *config) {
+		c.header = header
+	}
+}
+
+// WithHDF5Dataset sets the name of the hdf5 dataset holding the vectors
+func WithHDF5Dataset(name string) Option {
+	return func(c *config) {
+		if name != "" {
+			c.hdf5Dataset = name
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dataset provides readers of the vector dataset files used to pre-fill an index
+package dataset
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// vecsReader reads the fvecs, ivecs and bvecs files,
+// each record is the little endian int32 dimension followed by the elements.
+type vecsReader struct {
+	path string
+	f    *os.File
+	r    *bufio.Reader
+	size int
+	elem func([]byte) float64
+	head [4]byte
+	buf  []byte
+	n    int
+	dim  int // dimension of the first record, the following records must match it
+}
+
+// maxVecsDimension bounds the dimension read from a record header before allocating its buffer.
+const maxVecsDimension = 1 << 16
+
+func float32Elem(b []byte) float64 {
+	return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+}
+
+func int32Elem(b []byte) float64 {
+	return float64(int32(binary.LittleEndian.Uint32(b)))
+}
+
+func uint8Elem(b []byte) float64 {
+	return float64(b[0])
+}
+
+func openVecs(path string, size int, elem func([]byte) float64) (Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &vecsReader{
+		path: path,
+		f:    f,
+		r:    bufio.NewReader(f),
+		size: size,
+		elem: elem,
+	}, nil
+}
+
+func (v *vecsReader) Read() (string, []float64, error) {
+	_, err := io.ReadFull(v.r, v.head[:])
+	if err != nil {
+		if err == io.EOF {
+			return "", nil, err
+		}
+		return "", nil, errors.ErrInvalidDatasetRecord(err, v.path, v.n)
+	}
+
+	dim := int(int32(binary.LittleEndian.Uint32(v.head[:])))
+	if dim <= 0 || dim > maxVecsDimension {
+		return "", nil, errors.ErrInvalidDatasetRecord(errors.ErrInvalidDatasetDimension(dim), v.path, v.n)
+	}
+	if v.dim == 0 {
+		v.dim = dim
+	}
+	if dim != v.dim {
+		return "", nil, errors.ErrInvalidDatasetRecord(errors.ErrDatasetDimensionMismatch(dim, v.dim), v.path, v.n)
+	}
+	if cap(v.buf) < dim*v.size {
+		v.buf = make([]byte, dim*v.size)
+	}
+	buf := v.buf[:dim*v.size]
+	_, err = io.ReadFull(v.r, buf)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", nil, errors.ErrInvalidDatasetRecord(err, v.path, v.n)
+	}
+
+	vec := make([]float64, dim)
+	for i := range vec {
+		vec[i] = v.elem(buf[i*v.size:])
+	}
+	v.n++
+	return "", vec, nil
+}
+
+func (v *vecsReader) Close() error {
+	return v.f.Close()
+}
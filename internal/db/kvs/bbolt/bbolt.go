@@ -0,0 +1,127 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package bbolt provides the embedded key value store backed by bbolt
+package bbolt
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+type Bolt interface {
+	// Get returns the values of the keys, the value of the missing key is nil.
+	Get(ctx context.Context, keys ...string) ([][]byte, error)
+	Set(ctx context.Context, kvs map[string][]byte) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+type db struct {
+	path    string
+	bucket  []byte
+	timeout time.Duration
+	noSync  bool
+	db      *bolt.DB
+}
+
+func New(opts ...Option) (Bolt, error) {
+	b := new(db)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(b)
+	}
+	if b.path == "" {
+		return nil, errors.ErrBoltPathNotFound
+	}
+	if err := os.MkdirAll(filepath.Dir(b.path), 0755); err != nil {
+		return nil, errors.ErrBoltOpenFailed(err, b.path)
+	}
+
+	var err error
+	b.db, err = bolt.Open(b.path, 0600, &bolt.Options{
+		Timeout: b.timeout,
+		NoSync:  b.noSync,
+	})
+	if err != nil {
+		return nil, errors.ErrBoltOpenFailed(err, b.path)
+	}
+	err = b.db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(b.bucket)
+		return err
+	})
+	if err != nil {
+		b.db.Close()
+		return nil, errors.ErrBoltOpenFailed(err, b.path)
+	}
+	return b, nil
+}
+
+func (b *db) Get(ctx context.Context, keys ...string) ([][]byte, error) {
+	vals := make([][]byte, len(keys))
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bk := tx.Bucket(b.bucket)
+		for i, key := range keys {
+			// the value is only valid during the transaction
+			if v := bk.Get([]byte(key)); v != nil {
+				vals[i] = append(make([]byte, 0, len(v)), v...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+func (b *db) Set(ctx context.Context, kvs map[string][]byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bk := tx.Bucket(b.bucket)
+		for k, v := range kvs {
+			if err := bk.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *db) Delete(ctx context.Context, keys ...string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bk := tx.Bucket(b.bucket)
+		for _, k := range keys {
+			if err := bk.Delete([]byte(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *db) Close() error {
+	return b.db.Close()
+}
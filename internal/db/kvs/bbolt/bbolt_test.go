@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package bbolt provides the embedded key value store backed by bbolt
+package bbolt
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestBolt(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "meta", "meta.db")
+
+	db, err := New(WithPath(path), WithBucket("meta"))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+
+	ctx := context.Background()
+	if err := db.Set(ctx, map[string][]byte{
+		"uuid-1": []byte("a"),
+		"uuid-2": []byte("b"),
+	}); err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	if err := db.Set(ctx, map[string][]byte{"uuid-2": []byte("c")}); err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+
+	got, err := db.Get(ctx, "uuid-1", "uuid-3", "uuid-2")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	if wants := [][]byte{[]byte("a"), nil, []byte("c")}; !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestBolt: %q, wanted: %q", got, wants)
+	}
+
+	if err := db.Delete(ctx, "uuid-1", "uuid-3"); err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	got, err = db.Get(ctx, "uuid-1", "uuid-2")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	if wants := [][]byte{nil, []byte("c")}; !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestBolt: %q, wanted: %q", got, wants)
+	}
+
+	// the values are persisted across reopening
+	if err := db.Close(); err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	db, err = New(WithPath(path), WithBucket("meta"))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	defer db.Close()
+	got, err = db.Get(ctx, "uuid-2")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBolt(%v)", err)
+	}
+	if wants := [][]byte{[]byte("c")}; !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestBolt: %q, wanted: %q", got, wants)
+	}
+}
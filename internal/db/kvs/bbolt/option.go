@@ -0,0 +1,75 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package bbolt provides the embedded key value store backed by bbolt
+package bbolt
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type Option func(*db)
+
+var (
+	defaultOpts = []Option{
+		WithBucket("vald"),
+		WithOpenTimeout("5s"),
+	}
+)
+
+func WithPath(path string) Option {
+	return func(b *db) {
+		b.path = path
+	}
+}
+
+func WithBucket(bucket string) Option {
+	return func(b *db) {
+		if bucket == "" {
+			return
+		}
+		b.bucket = []byte(bucket)
+	}
+}
+
+// WithOpenTimeout sets the timeout of waiting for the file lock held by the other process
+func WithOpenTimeout(dur string) Option {
+	return func(b *db) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			d = 5 * time.Second
+		}
+		b.timeout = d
+	}
+}
+
+// WithNoSync disables fsync after each commit, the recent writes may be lost by the system crash
+func WithNoSync(noSync bool) Option {
+	return func(b *db) {
+		b.noSync = noSync
+	}
+}
@@ -0,0 +1,107 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package redis provides the key value store backed by redis
+package redis
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type Option func(*client)
+
+var (
+	defaultOpts = []Option{
+		WithDialTimeout("5s"),
+		WithReadTimeout("3s"),
+		WithWriteTimeout("3s"),
+	}
+)
+
+func WithAddrs(addrs ...string) Option {
+	return func(c *client) {
+		for _, addr := range addrs {
+			if addr != "" {
+				c.addrs = append(c.addrs, addr)
+			}
+		}
+	}
+}
+
+func WithPassword(password string) Option {
+	return func(c *client) {
+		c.password = password
+	}
+}
+
+// WithDB selects the database of the single server, which is ignored by the cluster client
+func WithDB(db int) Option {
+	return func(c *client) {
+		c.db = db
+	}
+}
+
+func WithKeyPrefix(prefix string) Option {
+	return func(c *client) {
+		c.prefix = prefix
+	}
+}
+
+func WithDialTimeout(dur string) Option {
+	return func(c *client) {
+		c.dialTimeout = parse(dur, c.dialTimeout)
+	}
+}
+
+func WithReadTimeout(dur string) Option {
+	return func(c *client) {
+		c.readTimeout = parse(dur, c.readTimeout)
+	}
+}
+
+func WithWriteTimeout(dur string) Option {
+	return func(c *client) {
+		c.writeTimeout = parse(dur, c.writeTimeout)
+	}
+}
+
+func WithPoolSize(size int) Option {
+	return func(c *client) {
+		if size <= 0 {
+			return
+		}
+		c.poolSize = size
+	}
+}
+
+func parse(dur string, def time.Duration) time.Duration {
+	if dur == "" {
+		return def
+	}
+	d, err := timeutil.Parse(dur)
+	if err != nil {
+		return def
+	}
+	return d
+}
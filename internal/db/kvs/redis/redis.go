@@ -0,0 +1,128 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package redis provides the key value store backed by redis
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type Redis interface {
+	// Get returns the values of the keys, the value of the missing key is nil.
+	Get(ctx context.Context, keys ...string) ([][]byte, error)
+	Set(ctx context.Context, kvs map[string][]byte) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+type client struct {
+	addrs        []string
+	password     string
+	db           int
+	prefix       string
+	dialTimeout  time.Duration
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+	poolSize     int
+	client       redis.UniversalClient
+}
+
+// New connects to the redis server, the cluster client is used for the multiple addresses.
+func New(opts ...Option) (Redis, error) {
+	c := new(client)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(c)
+	}
+	if len(c.addrs) == 0 {
+		return nil, errors.ErrRedisAddrsNotFound
+	}
+	c.client = redis.NewUniversalClient(&redis.UniversalOptions{
+		Addrs:        c.addrs,
+		Password:     c.password,
+		DB:           c.db,
+		DialTimeout:  c.dialTimeout,
+		ReadTimeout:  c.readTimeout,
+		WriteTimeout: c.writeTimeout,
+		PoolSize:     c.poolSize,
+	})
+	if err := c.client.Ping().Err(); err != nil {
+		c.client.Close()
+		return nil, errors.ErrRedisConnectionFailed(err, c.addrs)
+	}
+	return c, nil
+}
+
+// Get pipelines GET commands instead of MGET, since the keys of MGET must be in the same slot of the cluster.
+func (c *client) Get(ctx context.Context, keys ...string) ([][]byte, error) {
+	cmds := make([]*redis.StringCmd, 0, len(keys))
+	_, err := c.client.Pipelined(func(p redis.Pipeliner) error {
+		for _, key := range keys {
+			cmds = append(cmds, p.Get(c.prefix+key))
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	vals := make([][]byte, len(keys))
+	for i, cmd := range cmds {
+		v, err := cmd.Bytes()
+		switch err {
+		case nil:
+			vals[i] = v
+		case redis.Nil:
+		default:
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+func (c *client) Set(ctx context.Context, kvs map[string][]byte) error {
+	_, err := c.client.Pipelined(func(p redis.Pipeliner) error {
+		for k, v := range kvs {
+			p.Set(c.prefix+k, v, 0)
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *client) Delete(ctx context.Context, keys ...string) error {
+	_, err := c.client.Pipelined(func(p redis.Pipeliner) error {
+		for _, k := range keys {
+			p.Del(c.prefix + k)
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *client) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,93 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package redis provides the key value store backed by redis
+package redis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestRedis(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+	defer s.Close()
+
+	db, err := New(WithAddrs(s.Addr()), WithKeyPrefix("meta/"))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Set(ctx, map[string][]byte{
+		"uuid-1": []byte("a"),
+		"uuid-2": []byte("b"),
+	}); err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+	if err := db.Set(ctx, map[string][]byte{"uuid-2": []byte("c")}); err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+
+	got, err := db.Get(ctx, "uuid-1", "uuid-3", "uuid-2")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+	if wants := [][]byte{[]byte("a"), nil, []byte("c")}; !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestRedis: %q, wanted: %q", got, wants)
+	}
+
+	if err := db.Delete(ctx, "uuid-1", "uuid-3"); err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+	got, err = db.Get(ctx, "uuid-1", "uuid-2")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRedis(%v)", err)
+	}
+	if wants := [][]byte{nil, []byte("c")}; !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestRedis: %q, wanted: %q", got, wants)
+	}
+
+	if keys := s.Keys(); !reflect.DeepEqual(keys, []string{"meta/uuid-2"}) {
+		t.Errorf("TestRedis: %v, wanted the prefixed key", keys)
+	}
+}
+
+func TestRedisConnectionFailed(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRedisConnectionFailed(%v)", err)
+	}
+	addr := s.Addr()
+	s.Close()
+
+	if _, err := New(WithAddrs(addr)); err == nil {
+		t.Errorf("TestRedisConnectionFailed: wanted the connection error")
+	}
+}
@@ -0,0 +1,157 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package fs provides the blob storage on the local filesystem
+package fs
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+type bucket struct {
+	dir string
+}
+
+func New(opts ...Option) (blob.Bucket, error) {
+	b := new(bucket)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(b)
+	}
+	if b.dir == "" {
+		return nil, errors.ErrBlobDirNotFound
+	}
+	if err := os.MkdirAll(b.dir, 0755); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// path returns the file path of the key, which is rejected when it points outside of the directory.
+func (b *bucket) path(key string) (string, error) {
+	p := filepath.Join(b.dir, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(p, filepath.Clean(b.dir)+string(filepath.Separator)) {
+		return "", errors.ErrInvalidBlobKey(key)
+	}
+	return p, nil
+}
+
+func (b *bucket) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := b.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return nil, errors.ErrBlobNotFound(key)
+	}
+	return f, err
+}
+
+// Writer writes the blob to the temporary file renamed to the key on close.
+func (b *bucket) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	p, err := b.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return nil, err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(p), "."+filepath.Base(p)+".tmp-")
+	if err != nil {
+		return nil, err
+	}
+	return &writer{
+		File: f,
+		path: p,
+	}, nil
+}
+
+type writer struct {
+	*os.File
+	path string
+}
+
+func (w *writer) Close() (err error) {
+	defer func() {
+		if err != nil {
+			os.Remove(w.Name())
+		}
+	}()
+	if err = w.Sync(); err != nil {
+		w.File.Close()
+		return err
+	}
+	if err = w.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.Name(), w.path)
+}
+
+func (b *bucket) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.Walk(b.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return nil
+		}
+		rel, err := filepath.Rel(b.dir, p)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (b *bucket) Delete(ctx context.Context, key string) error {
+	p, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(p)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (b *bucket) Close() error {
+	return nil
+}
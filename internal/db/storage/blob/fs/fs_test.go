@@ -0,0 +1,99 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package fs provides the blob storage on the local filesystem
+package fs
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestBucket(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	b, err := New(WithDir(tmpdir))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	defer b.Close()
+
+	ctx := context.Background()
+	for key, val := range map[string]string{
+		"agent-1/default/2/snapshot": "snapshot-2",
+		"agent-1/default/1/snapshot": "snapshot-1",
+		"agent-2/default/1/snapshot": "snapshot-3",
+	} {
+		w, err := b.Writer(ctx, key)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestBucket(%v)", err)
+		}
+		if _, err := io.WriteString(w, val); err != nil {
+			t.Fatalf("Unexpected error: TestBucket(%v)", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Unexpected error: TestBucket(%v)", err)
+		}
+	}
+
+	keys, err := b.List(ctx, "agent-1/")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	if wants := []string{"agent-1/default/1/snapshot", "agent-1/default/2/snapshot"}; !reflect.DeepEqual(keys, wants) {
+		t.Errorf("TestBucket: %v, wanted: %v", keys, wants)
+	}
+
+	r, err := b.Reader(ctx, "agent-2/default/1/snapshot")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	got, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	if string(got) != "snapshot-3" {
+		t.Errorf("TestBucket: %s, wanted: snapshot-3", got)
+	}
+
+	if err := b.Delete(ctx, "agent-2/default/1/snapshot"); err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	if _, err := b.Reader(ctx, "agent-2/default/1/snapshot"); !errors.IsBlobNotFound(err) {
+		t.Errorf("TestBucket: %v, wanted: %v", err, errors.ErrBlobNotFound("agent-2/default/1/snapshot"))
+	}
+
+	if _, err := b.Writer(ctx, "../escape"); err == nil {
+		t.Error("TestBucket: wanted the invalid key error")
+	}
+}
@@ -20,10 +20,17 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
 
-// Package model defines object structure
-package model
+// Package fs provides the blob storage on the local filesystem
+package fs
 
-type Distance struct {
-	ID       string
-	Distance float32
+type Option func(*bucket)
+
+var (
+	defaultOpts = []Option{}
+)
+
+func WithDir(dir string) Option {
+	return func(b *bucket) {
+		b.dir = dir
+	}
 }
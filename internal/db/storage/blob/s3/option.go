@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package s3 provides the blob storage on the S3 compatible object storage
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type Option func(*bucket)
+
+var (
+	defaultOpts = []Option{
+		WithRegion("us-east-1"),
+		WithPartSize(s3manager.DefaultUploadPartSize),
+	}
+)
+
+// WithEndpoint sets the endpoint of the S3 compatible storage, the AWS endpoint of the region is used when empty
+func WithEndpoint(endpoint string) Option {
+	return func(b *bucket) {
+		b.endpoint = endpoint
+	}
+}
+
+func WithRegion(region string) Option {
+	return func(b *bucket) {
+		if region == "" {
+			return
+		}
+		b.region = region
+	}
+}
+
+func WithBucket(name string) Option {
+	return func(b *bucket) {
+		b.bucket = name
+	}
+}
+
+// WithPrefix sets the prefix of the object keys
+func WithPrefix(prefix string) Option {
+	return func(b *bucket) {
+		b.prefix = prefix
+	}
+}
+
+func WithAccessKey(key string) Option {
+	return func(b *bucket) {
+		b.accessKey = key
+	}
+}
+
+func WithSecretKey(key string) Option {
+	return func(b *bucket) {
+		b.secretKey = key
+	}
+}
+
+// WithForcePathStyle addresses the bucket by the path instead of the virtual host, which most S3 compatible storages require
+func WithForcePathStyle(force bool) Option {
+	return func(b *bucket) {
+		b.forcePathStyle = force
+	}
+}
+
+func WithPartSize(size int64) Option {
+	return func(b *bucket) {
+		if size < s3manager.MinUploadPartSize {
+			return
+		}
+		b.partSize = size
+	}
+}
@@ -0,0 +1,159 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package s3 provides the blob storage on the S3 compatible object storage
+package s3
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+type bucket struct {
+	endpoint       string
+	region         string
+	bucket         string
+	prefix         string
+	accessKey      string
+	secretKey      string
+	forcePathStyle bool
+	partSize       int64
+	client         *s3.S3
+	uploader       *s3manager.Uploader
+}
+
+// New connects to the bucket, the default credential chain is used when the access key is not given.
+func New(opts ...Option) (blob.Bucket, error) {
+	b := new(bucket)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(b)
+	}
+	if b.bucket == "" {
+		return nil, errors.ErrBlobBucketNotFound
+	}
+
+	cfg := aws.NewConfig().
+		WithRegion(b.region).
+		WithS3ForcePathStyle(b.forcePathStyle)
+	if b.endpoint != "" {
+		cfg = cfg.WithEndpoint(b.endpoint)
+	}
+	if b.accessKey != "" {
+		cfg = cfg.WithCredentials(credentials.NewStaticCredentials(b.accessKey, b.secretKey, ""))
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+	b.client = s3.New(sess)
+	b.uploader = s3manager.NewUploaderWithClient(b.client, func(u *s3manager.Uploader) {
+		u.PartSize = b.partSize
+	})
+	return b, nil
+}
+
+func (b *bucket) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	res, err := b.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.prefix + key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, errors.ErrBlobNotFound(key)
+		}
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// Writer uploads the blob by parts while it is written, the upload completes when the writer is closed.
+func (b *bucket) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	pr, pw := io.Pipe()
+	w := &writer{
+		PipeWriter: pw,
+		done:       make(chan error, 1),
+	}
+	_, eg := errgroup.New(ctx)
+	eg.Go(safety.RecoverFunc(func() error {
+		_, err := b.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket: aws.String(b.bucket),
+			Key:    aws.String(b.prefix + key),
+			Body:   pr,
+		})
+		pr.CloseWithError(err)
+		w.done <- err
+		return nil
+	}))
+	return w, nil
+}
+
+type writer struct {
+	*io.PipeWriter
+	done chan error
+}
+
+func (w *writer) Close() error {
+	w.PipeWriter.Close()
+	return <-w.done
+}
+
+func (b *bucket) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := b.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(b.prefix + prefix),
+	}, func(page *s3.ListObjectsV2Output, last bool) bool {
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.StringValue(obj.Key)[len(b.prefix):])
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (b *bucket) Delete(ctx context.Context, key string) error {
+	_, err := b.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.prefix + key),
+	})
+	return err
+}
+
+func (b *bucket) Close() error {
+	return nil
+}
@@ -0,0 +1,197 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package s3 provides the blob storage on the S3 compatible object storage
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// fakeS3 is the in-process stand-in of the path style S3 API serving the single part objects of a bucket.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string   `xml:"Name"`
+	Prefix      string   `xml:"Prefix"`
+	KeyCount    int      `xml:"KeyCount"`
+	IsTruncated bool     `xml:"IsTruncated"`
+	Contents    []struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	} `xml:"Contents"`
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path != f.bucket && !strings.HasPrefix(path, f.bucket+"/") {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(path, f.bucket), "/")
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		prefix := r.URL.Query().Get("prefix")
+		res := listBucketResult{Name: f.bucket, Prefix: prefix}
+		keys := make([]string, 0, len(f.objects))
+		for k := range f.objects {
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			res.Contents = append(res.Contents, struct {
+				Key  string `xml:"Key"`
+				Size int    `xml:"Size"`
+			}{k, len(f.objects[k])})
+		}
+		res.KeyCount = len(keys)
+		w.Header().Set("Content-Type", "application/xml")
+		xml.NewEncoder(w).Encode(res)
+	case r.Method == http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			f.error(w, http.StatusInternalServerError, "InternalError")
+			return
+		}
+		f.objects[key] = data
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Write(data)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, code int, s3code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(code)
+	io.WriteString(w, "<Error><Code>"+s3code+"</Code><Message>"+s3code+"</Message></Error>")
+}
+
+func TestBucket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errgroup.Init(ctx)
+
+	f := &fakeS3{
+		bucket:  "vald",
+		objects: make(map[string][]byte),
+	}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	b, err := New(
+		WithEndpoint(srv.URL),
+		WithBucket("vald"),
+		WithPrefix("backup/"),
+		WithAccessKey("access"),
+		WithSecretKey("secret"),
+		WithForcePathStyle(true),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	defer b.Close()
+
+	for key, val := range map[string]string{
+		"agent-1/default/2/snapshot": "snapshot-2",
+		"agent-1/default/1/snapshot": "snapshot-1",
+		"agent-2/default/1/snapshot": "snapshot-3",
+	} {
+		w, err := b.Writer(ctx, key)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestBucket(%v)", err)
+		}
+		if _, err := io.WriteString(w, val); err != nil {
+			t.Fatalf("Unexpected error: TestBucket(%v)", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Unexpected error: TestBucket(%v)", err)
+		}
+	}
+
+	keys, err := b.List(ctx, "agent-1/")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	if wants := []string{"agent-1/default/1/snapshot", "agent-1/default/2/snapshot"}; !reflect.DeepEqual(keys, wants) {
+		t.Errorf("TestBucket: %v, wanted: %v", keys, wants)
+	}
+
+	r, err := b.Reader(ctx, "agent-2/default/1/snapshot")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	got, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	if string(got) != "snapshot-3" {
+		t.Errorf("TestBucket: %s, wanted: snapshot-3", got)
+	}
+
+	if err := b.Delete(ctx, "agent-2/default/1/snapshot"); err != nil {
+		t.Fatalf("Unexpected error: TestBucket(%v)", err)
+	}
+	if _, err := b.Reader(ctx, "agent-2/default/1/snapshot"); !errors.IsBlobNotFound(err) {
+		t.Errorf("TestBucket: %v, wanted: %v", err, errors.ErrBlobNotFound("agent-2/default/1/snapshot"))
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.objects["backup/agent-1/default/1/snapshot"]; !ok {
+		t.Errorf("TestBucket: %v, wanted the prefixed keys", f.objects)
+	}
+}
@@ -100,6 +100,147 @@ var (
 
 	ErrTransportRetryable = New("transport is retryable")
 
+	ErrGRPCDialFailed = func(err error, addr string) error {
+		return Wrapf(err, "failed to dial grpc connection to %s", addr)
+	}
+
+	// Gateway
+
+	ErrAgentsNotFound = New("no agents are discovered")
+
+	ErrAgentRequestFailed = func(err error, addr string) error {
+		return Wrapf(err, "request to agent %s failed", addr)
+	}
+
+	ErrMetaManagerNotConfigured = New("meta manager address is not configured")
+
+	ErrMetaJoinFailed = func(err error) error {
+		return Wrap(err, "failed to join the search results with their metadata")
+	}
+
+	// Discoverer
+
+	ErrKubernetesClientInitFailed = func(err error) error {
+		return Wrap(err, "failed to initialize kubernetes client")
+	}
+
+	ErrInvalidLabelSelector = func(err error, selector string) error {
+		return Wrapf(err, "invalid label selector %s", selector)
+	}
+
+	ErrInformerCacheNotSynced = New("informer cache is not synced")
+
+	ErrDiscovererWatchLagged = New("agent watch fell behind the membership changes")
+
+	ErrInvalidAgentIP = func(ip string) error {
+		return Errorf("invalid agent ip %s", ip)
+	}
+
+	ErrDNSNameNotFound = New("dns name of the agents is not configured")
+
+	ErrDNSLookupFailed = func(err error, name string) error {
+		return Wrapf(err, "failed to look up %s", name)
+	}
+
+	ErrOpenStackAuthURLNotFound = New("openstack auth url is not configured")
+
+	ErrOpenStackAuthFailed = func(err error) error {
+		return Wrap(err, "failed to authenticate to keystone")
+	}
+
+	ErrOpenStackComputeEndpointNotFound = func(region string) error {
+		return Errorf("compute endpoint of region %s is not found in the service catalog", region)
+	}
+
+	ErrOpenStackUnauthorized = New("openstack token is unauthorized")
+
+	ErrOpenStackUnexpectedStatus = func(url string, code int) error {
+		return Errorf("unexpected status code %d from %s", code, url)
+	}
+
+	ErrOpenStackListServersFailed = func(err error) error {
+		return Wrap(err, "failed to list servers")
+	}
+
+	// KVS
+
+	ErrBoltPathNotFound = New("bbolt database file path is not configured")
+
+	ErrBoltOpenFailed = func(err error, path string) error {
+		return Wrapf(err, "failed to open bbolt database %s", path)
+	}
+
+	ErrRedisAddrsNotFound = New("redis addresses are not configured")
+
+	ErrRedisConnectionFailed = func(err error, addrs []string) error {
+		return Wrapf(err, "failed to connect to redis %v", addrs)
+	}
+
+	// Blob
+
+	ErrBlobDirNotFound = New("blob directory is not configured")
+
+	ErrBlobBucketNotFound = New("blob bucket is not configured")
+
+	ErrInvalidBlobKey = func(key string) error {
+		return Errorf("invalid blob key %s", key)
+	}
+
+	// ErrBlobNotExist is the cause of ErrBlobNotFound, test it with IsBlobNotFound
+	ErrBlobNotExist = New("blob does not exist")
+
+	ErrBlobNotFound = func(key string) error {
+		return Wrapf(ErrBlobNotExist, "blob %s not found", key)
+	}
+
+	IsBlobNotFound = func(err error) bool {
+		return errors.Is(err, ErrBlobNotExist)
+	}
+
+	ErrInvalidBlobStorage = func(storage string) error {
+		return Errorf("invalid blob storage %s", storage)
+	}
+
+	// Backup
+
+	ErrBackupAgentsNotFound = New("agents to backup not found")
+
+	ErrBackupNotFound = func(id string) error {
+		return Errorf("backup %s not found", id)
+	}
+
+	ErrBackupChecksumMismatch = func(id string) error {
+		return Errorf("checksum of backup %s mismatched", id)
+	}
+
+	// Replication
+
+	ErrReplicationAgentsNotFound = New("agents to replicate not found")
+
+	ErrReplicationListFailed = func(err error, addr string) error {
+		return Wrapf(err, "failed to list the objects of agent %s", addr)
+	}
+
+	// Traffic
+
+	ErrTrafficUnknownAPIKey = New("unknown api key")
+
+	ErrTrafficLimited = func(tenant, reason string) error {
+		return Errorf("traffic of tenant %s is limited: %s", tenant, reason)
+	}
+
+	// Meta
+
+	ErrMetaNotFound = func(uuid string) error {
+		return Errorf("meta of uuid %s not found", uuid)
+	}
+
+	ErrEmptyMetaUUID = New("uuid of meta is empty")
+
+	ErrInvalidMetaStore = func(store string) error {
+		return Errorf("invalid meta store %s", store)
+	}
+
 	//NGT
 
 	ErrCreateProperty = func(err error) error {
@@ -132,6 +273,14 @@ var (
 		return Wrap(err, "failed to set search edge size")
 	}
 
+	ErrInvalidDimensionSize = func(current, limit int) error {
+		return Errorf("dimension size %d is invalid, the supporting dimension size must be %d", current, limit)
+	}
+
+	ErrVectorTooShort = func(size, min int) error {
+		return Errorf("vector size %d is smaller than %d", size, min)
+	}
+
 	// ErrCAPINotImplemented raises using not implemented function in C API
 	ErrCAPINotImplemented = New("not implemented in C API")
 
@@ -143,6 +292,10 @@ var (
 		return Errorf("ngt object id %d's metadata not found", id)
 	}
 
+	ErrUUIDDuplicated = func(uuid string) error {
+		return Errorf("uuid %s is given more than once", uuid)
+	}
+
 	ErrObjectIDNotFound = func(uuid string) error {
 		return Errorf("ngt uuid %s's object id not found", uuid)
 	}
@@ -155,6 +308,96 @@ var (
 		return Errorf("object id %d is not indexed we cannnot remove it", oid)
 	}
 
+	ErrKVSNotFound = func(path string) error {
+		return Errorf("ngt uuid mapping file %s not found", path)
+	}
+
+	ErrKVSLoadFailed = func(err error, path string) error {
+		return Wrapf(err, "failed to load ngt uuid mapping file %s", path)
+	}
+
+	ErrDuplicatedObjectID = func(oid uint32, uuid, dup string) error {
+		return Errorf("ngt object id %d is mapped to both uuid %s and %s", oid, uuid, dup)
+	}
+
+	ErrObjectCountMismatch = func(objects uint64, uuids int) error {
+		return Errorf("ngt index has %d objects while %d uuids are mapped", objects, uuids)
+	}
+
+	ErrIndexKVSInconsistent = func(err error) error {
+		return Wrap(err, "ngt index and uuid mapping are inconsistent")
+	}
+
+	ErrNamedIndexNotFound = func(name string) error {
+		return Errorf("ngt index %s not found", name)
+	}
+
+	ErrDuplicatedIndexName = func(name string) error {
+		return Errorf("ngt index name %s is duplicated", name)
+	}
+
+	// WAL
+
+	ErrWALAppendFailed = func(err error, path string) error {
+		return Wrapf(err, "failed to append to write-ahead log %s", path)
+	}
+
+	ErrWALCorrupted = func(offset int64) error {
+		return Errorf("write-ahead log record at offset %d is corrupted", offset)
+	}
+
+	ErrWALInvalidEntry = New("invalid write-ahead log entry")
+
+	ErrWALTailTruncated = func(err error, path string, offset int64) error {
+		return Wrapf(err, "write-ahead log %s truncated at offset %d", path, offset)
+	}
+
+	ErrWALReplayFailed = func(err error, uuid string) error {
+		return Wrapf(err, "failed to replay write-ahead log entry of uuid %s", uuid)
+	}
+
+	// Archive
+
+	ErrInvalidArchiveEntry = func(name string) error {
+		return Errorf("invalid archive entry %s", name)
+	}
+
+	// Dataset
+
+	ErrUnsupportedDatasetFormat = func(format string) error {
+		return Errorf("unsupported dataset format %s", format)
+	}
+
+	ErrInvalidDatasetRecord = func(err error, path string, n int) error {
+		return Wrapf(err, "invalid record %d in dataset %s", n, path)
+	}
+
+	ErrInvalidDatasetDimension = func(dim int) error {
+		return Errorf("dataset dimension %d is invalid", dim)
+	}
+
+	ErrDatasetDimensionMismatch = func(dim, wants int) error {
+		return Errorf("dataset dimension %d differs from the first record dimension %d", dim, wants)
+	}
+
+	ErrInvalidDatasetShape = func(name string, dims []uint) error {
+		return Errorf("dataset %s of shape %v is not a non-empty two dimensional dataset", name, dims)
+	}
+
+	ErrDatasetIDColumnNotFound = func(col int) error {
+		return Errorf("dataset id column %d not found", col)
+	}
+
+	ErrImportDirNotConfigured = New("import directory is not configured")
+
+	ErrImportPathOutsideDir = func(path, dir string) error {
+		return Errorf("import path %s is outside of the import directory %s", path, dir)
+	}
+
+	ErrDuplicatedDatasetUUID = func(uuid string) error {
+		return Errorf("uuid %s appears more than once in the dataset", uuid)
+	}
+
 	// Runtime
 
 	ErrPanicRecovered = func(err error, rec interface{}) error {
@@ -0,0 +1,65 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package membership provides the agent membership of the discoverers which publishes its changes to the watchers
+package membership
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/vdaas/vald/apis/grpc/discoverer"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ReadyState is the state the discoverers report for the agents ready to serve, the agents without state are considered ready.
+	ReadyState = "Ready"
+)
+
+// IsReady reports whether the agent is ready to serve.
+func IsReady(a *payload.Info_Agent) bool {
+	return a.GetState() == "" || a.GetState() == ReadyState
+}
+
+// Addrs returns the gRPC addresses of the ready agents serving on the port.
+func Addrs(agents []*payload.Info_Agent, port int) []string {
+	addrs := make([]string, 0, len(agents))
+	for _, a := range agents {
+		if !IsReady(a) {
+			continue
+		}
+		addrs = append(addrs, net.JoinHostPort(a.GetIp(), strconv.Itoa(port)))
+	}
+	return addrs
+}
+
+// Discover returns the gRPC addresses of the ready agents listed by the discoverer of the connection.
+func Discover(ctx context.Context, conn *grpc.ClientConn, port int) ([]string, error) {
+	res, err := discoverer.NewDiscovererClient(conn).Discover(ctx, new(payload.Common_Empty))
+	if err != nil {
+		return nil, err
+	}
+	return Addrs(res.GetAgents(), port), nil
+}
@@ -0,0 +1,162 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package membership provides the agent membership of the discoverers which publishes its changes to the watchers
+package membership
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Members holds the agents found by a discoverer.
+type Members interface {
+	// Set replaces the agents and publishes the differences to the watchers.
+	Set(agents []*payload.Info_Agent)
+	// Agents returns the agents sorted by the ip.
+	Agents() []*payload.Info_Agent
+	// Watch calls f with the snapshot of the agents and then with every membership change until the context is canceled.
+	Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error
+}
+
+type members struct {
+	mu         sync.Mutex
+	agents     map[string]*payload.Info_Agent
+	subs       map[uint64]chan *payload.Info_AgentEvent
+	nextID     uint64
+	bufferSize int
+}
+
+func New(opts ...Option) Members {
+	m := &members{
+		agents: make(map[string]*payload.Info_Agent),
+		subs:   make(map[uint64]chan *payload.Info_AgentEvent),
+	}
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(m)
+	}
+	return m
+}
+
+func (m *members) Set(agents []*payload.Info_Agent) {
+	next := make(map[string]*payload.Info_Agent, len(agents))
+	for _, a := range agents {
+		next[a.GetIp()] = a
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, a := range sorted(m.agents) {
+		if _, ok := next[a.GetIp()]; !ok {
+			m.publish(payload.Info_AgentEvent_REMOVE, a)
+		}
+	}
+	for _, a := range sorted(next) {
+		prev, ok := m.agents[a.GetIp()]
+		switch {
+		case !ok:
+			m.publish(payload.Info_AgentEvent_ADD, a)
+		case prev.GetState() != a.GetState():
+			m.publish(payload.Info_AgentEvent_UPDATE, a)
+		}
+	}
+	m.agents = next
+}
+
+func (m *members) Agents() []*payload.Info_Agent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return sorted(m.agents)
+}
+
+func (m *members) Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error {
+	ch := make(chan *payload.Info_AgentEvent, m.bufferSize)
+	m.mu.Lock()
+	agents := sorted(m.agents)
+	id := m.nextID
+	m.nextID++
+	m.subs[id] = ch
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		delete(m.subs, id)
+		m.mu.Unlock()
+	}()
+
+	err := f(&payload.Info_AgentEvent{
+		Type: payload.Info_AgentEvent_SNAPSHOT,
+		Snapshot: &payload.Info_Agents{
+			Agents: agents,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-ch:
+			if !ok {
+				return errors.ErrDiscovererWatchLagged
+			}
+			err = f(ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publish sends the event to the watchers, the watcher whose buffer is full is dropped to resync by watching again.
+func (m *members) publish(typ payload.Info_AgentEvent_Type, a *payload.Info_Agent) {
+	ev := &payload.Info_AgentEvent{
+		Type:  typ,
+		Agent: a,
+	}
+	for id, ch := range m.subs {
+		select {
+		case ch <- ev:
+		default:
+			close(ch)
+			delete(m.subs, id)
+		}
+	}
+}
+
+func sorted(agents map[string]*payload.Info_Agent) []*payload.Info_Agent {
+	res := make([]*payload.Info_Agent, 0, len(agents))
+	for _, a := range agents {
+		res = append(res, a)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GetIp() < res[j].GetIp()
+	})
+	return res
+}
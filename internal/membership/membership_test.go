@@ -0,0 +1,152 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package membership provides the agent membership of the discoverers which publishes its changes to the watchers
+package membership
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func (m *members) watchers() []uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]uint64, 0, len(m.subs))
+	for id := range m.subs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func agent(ip, state string) *payload.Info_Agent {
+	return &payload.Info_Agent{
+		Ip:    ip,
+		State: state,
+	}
+}
+
+func event(typ payload.Info_AgentEvent_Type, ip, state string) string {
+	return typ.String() + " " + ip + " " + state
+}
+
+func TestSetPublishesDifferences(t *testing.T) {
+	m := New()
+	m.Set([]*payload.Info_Agent{
+		agent("10.0.0.2", "Ready"),
+		agent("10.0.0.1", "Ready"),
+		agent("10.0.0.3", "NotReady"),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evs := make(chan *payload.Info_AgentEvent, 10)
+	go m.Watch(ctx, func(ev *payload.Info_AgentEvent) error {
+		evs <- ev
+		return nil
+	})
+
+	snapshot := <-evs
+	var ips []string
+	for _, a := range snapshot.GetSnapshot().GetAgents() {
+		ips = append(ips, a.GetIp())
+	}
+	if wants := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}; snapshot.GetType() != payload.Info_AgentEvent_SNAPSHOT ||
+		!reflect.DeepEqual(ips, wants) {
+		t.Fatalf("TestSetPublishesDifferences: %v, wanted the snapshot of %v", snapshot, wants)
+	}
+
+	m.Set([]*payload.Info_Agent{
+		agent("10.0.0.1", "Ready"),
+		agent("10.0.0.3", "Ready"),
+		agent("10.0.0.4", "Ready"),
+	})
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		select {
+		case ev := <-evs:
+			got = append(got, event(ev.GetType(), ev.GetAgent().GetIp(), ev.GetAgent().GetState()))
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TestSetPublishesDifferences: no event after %v", got)
+		}
+	}
+	wants := []string{
+		event(payload.Info_AgentEvent_REMOVE, "10.0.0.2", "Ready"),
+		event(payload.Info_AgentEvent_UPDATE, "10.0.0.3", "Ready"),
+		event(payload.Info_AgentEvent_ADD, "10.0.0.4", "Ready"),
+	}
+	if !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestSetPublishesDifferences: %v, wanted: %v", got, wants)
+	}
+	select {
+	case ev := <-evs:
+		t.Errorf("TestSetPublishesDifferences: unexpected event %v", ev)
+	default:
+	}
+}
+
+func TestWatchDropsLaggingWatcher(t *testing.T) {
+	m := New(WithWatchBufferSize(1))
+	block := make(chan struct{})
+	errs := make(chan error, 1)
+	go func() {
+		errs <- m.Watch(context.Background(), func(ev *payload.Info_AgentEvent) error {
+			if ev.GetType() == payload.Info_AgentEvent_SNAPSHOT {
+				<-block
+			}
+			return nil
+		})
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(m.(*members).watchers()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("TestWatchDropsLaggingWatcher: watcher is not registered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	m.Set([]*payload.Info_Agent{agent("10.0.0.1", "Ready")})
+	m.Set([]*payload.Info_Agent{agent("10.0.0.2", "Ready")})
+	close(block)
+
+	if err := <-errs; err != errors.ErrDiscovererWatchLagged {
+		t.Errorf("TestWatchDropsLaggingWatcher: %v, wanted: %v", err, errors.ErrDiscovererWatchLagged)
+	}
+}
+
+func TestAddrs(t *testing.T) {
+	addrs := Addrs([]*payload.Info_Agent{
+		agent("10.0.0.1", ReadyState),
+		agent("10.0.0.2", "NotReady"),
+		agent("10.0.0.3", ""),
+		agent("fd00::4", ReadyState),
+	}, 8081)
+	if wants := []string{"10.0.0.1:8081", "10.0.0.3:8081", "[fd00::4]:8081"}; !reflect.DeepEqual(addrs, wants) {
+		t.Errorf("TestAddrs: %v, wanted: %v", addrs, wants)
+	}
+}
@@ -0,0 +1,78 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package grpctest provides the gRPC servers listening on the loopback for the tests
+package grpctest
+
+import (
+	"net"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"google.golang.org/grpc"
+)
+
+// Server is a gRPC server serving on a random loopback port.
+type Server struct {
+	*grpc.Server
+	Addr string
+	Port int
+}
+
+// NewServer starts the server of the services register registers.
+func NewServer(register func(srv *grpc.Server)) (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	srv := grpc.NewServer()
+	register(srv)
+	go srv.Serve(l)
+	return &Server{
+		Server: srv,
+		Addr:   l.Addr().String(),
+		Port:   l.Addr().(*net.TCPAddr).Port,
+	}, nil
+}
+
+// NewAgentServers starts a server for each agent and returns their addresses in the same order and the function stopping them.
+func NewAgentServers(agents ...agent.AgentServer) ([]string, func(), error) {
+	addrs := make([]string, 0, len(agents))
+	srvs := make([]*Server, 0, len(agents))
+	stop := func() {
+		for _, srv := range srvs {
+			srv.Stop()
+		}
+	}
+	for _, a := range agents {
+		a := a
+		srv, err := NewServer(func(srv *grpc.Server) {
+			agent.RegisterAgentServer(srv, a)
+		})
+		if err != nil {
+			stop()
+			return nil, nil, err
+		}
+		addrs = append(addrs, srv.Addr)
+		srvs = append(srvs, srv)
+	}
+	return addrs, stop, nil
+}
@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package grpc provides generic functionallity for grpc
+package grpc
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vdaas/vald/internal/errors"
+	"google.golang.org/grpc"
+)
+
+// Pool keeps a client connection per address.
+type Pool interface {
+	// Update connects the addresses not connected yet and closes the connections of the addresses not given.
+	Update(ctx context.Context, addrs []string) error
+	// Range calls f for each connection in the address order until f returns false.
+	Range(f func(addr string, conn *grpc.ClientConn) bool)
+	Get(addr string) (*grpc.ClientConn, bool)
+	Addrs() []string
+	Len() int
+	Close() error
+}
+
+type pool struct {
+	mu    sync.RWMutex
+	conns map[string]*grpc.ClientConn
+	addrs []string
+	opts  []grpc.DialOption
+}
+
+// NewPool returns a Pool dialing with the options, the connections are established in background.
+func NewPool(opts ...grpc.DialOption) Pool {
+	return &pool{
+		conns: make(map[string]*grpc.ClientConn),
+		opts:  opts,
+	}
+}
+
+func (p *pool) Update(ctx context.Context, addrs []string) (err error) {
+	next := make(map[string]*grpc.ClientConn, len(addrs))
+
+	p.mu.RLock()
+	for _, addr := range addrs {
+		if conn, ok := p.conns[addr]; ok {
+			next[addr] = conn
+		}
+	}
+	p.mu.RUnlock()
+
+	for _, addr := range addrs {
+		if _, ok := next[addr]; ok {
+			continue
+		}
+		conn, derr := grpc.DialContext(ctx, addr, p.opts...)
+		if derr != nil {
+			err = errors.Wrap(err, errors.ErrGRPCDialFailed(derr, addr).Error())
+			continue
+		}
+		next[addr] = conn
+	}
+
+	sorted := make([]string, 0, len(next))
+	for addr := range next {
+		sorted = append(sorted, addr)
+	}
+	sort.Strings(sorted)
+
+	p.mu.Lock()
+	prev := p.conns
+	p.conns, p.addrs = next, sorted
+	p.mu.Unlock()
+
+	for addr, conn := range prev {
+		if _, ok := next[addr]; !ok {
+			conn.Close()
+		}
+	}
+	return err
+}
+
+func (p *pool) Range(f func(addr string, conn *grpc.ClientConn) bool) {
+	p.mu.RLock()
+	addrs, conns := p.addrs, p.conns
+	p.mu.RUnlock()
+
+	for _, addr := range addrs {
+		if !f(addr, conns[addr]) {
+			return
+		}
+	}
+}
+
+func (p *pool) Get(addr string) (*grpc.ClientConn, bool) {
+	p.mu.RLock()
+	conn, ok := p.conns[addr]
+	p.mu.RUnlock()
+	return conn, ok
+}
+
+func (p *pool) Addrs() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.addrs
+}
+
+func (p *pool) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.addrs)
+}
+
+func (p *pool) Close() (err error) {
+	p.mu.Lock()
+	conns := p.conns
+	p.conns, p.addrs = make(map[string]*grpc.ClientConn), nil
+	p.mu.Unlock()
+
+	for _, conn := range conns {
+		if cerr := conn.Close(); cerr != nil {
+			err = errors.Wrap(err, cerr.Error())
+		}
+	}
+	return err
+}
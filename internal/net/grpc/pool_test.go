@@ -0,0 +1,72 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package grpc provides generic functionallity for grpc
+package grpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestPoolUpdate(t *testing.T) {
+	p := NewPool(grpc.WithInsecure())
+	defer p.Close()
+
+	ctx := context.Background()
+	if err := p.Update(ctx, []string{"127.0.0.2:8082", "127.0.0.1:8082"}); err != nil {
+		t.Fatalf("Unexpected error: TestPoolUpdate(%v)", err)
+	}
+	if wants := []string{"127.0.0.1:8082", "127.0.0.2:8082"}; !reflect.DeepEqual(p.Addrs(), wants) {
+		t.Errorf("TestPoolUpdate: %v, wanted: %v", p.Addrs(), wants)
+	}
+	kept, _ := p.Get("127.0.0.2:8082")
+
+	if err := p.Update(ctx, []string{"127.0.0.2:8082", "127.0.0.3:8082"}); err != nil {
+		t.Fatalf("Unexpected error: TestPoolUpdate(%v)", err)
+	}
+	if _, ok := p.Get("127.0.0.1:8082"); ok {
+		t.Error("TestPoolUpdate: removed address is still connected")
+	}
+	if conn, _ := p.Get("127.0.0.2:8082"); conn != kept {
+		t.Error("TestPoolUpdate: kept address is reconnected")
+	}
+
+	var addrs []string
+	p.Range(func(addr string, conn *grpc.ClientConn) bool {
+		addrs = append(addrs, addr)
+		return true
+	})
+	if wants := []string{"127.0.0.2:8082", "127.0.0.3:8082"}; !reflect.DeepEqual(addrs, wants) {
+		t.Errorf("TestPoolUpdate: %v, wanted: %v", addrs, wants)
+	}
+
+	if err := p.Close(); err != nil {
+		t.Errorf("Unexpected error: TestPoolUpdate(%v)", err)
+	}
+	if p.Len() != 0 {
+		t.Errorf("TestPoolUpdate: %d connections after Close, wanted: 0", p.Len())
+	}
+}
@@ -24,6 +24,7 @@
 package metrics
 
 import (
+	"expvar"
 	"net/http"
 	"net/http/pprof"
 
@@ -108,5 +109,13 @@ func NewPProfHandler() http.Handler {
 				"/debug/pprof/block",
 				rest.HandlerToRestFunc(pprof.Handler("block").ServeHTTP),
 			},
+			{
+				"Debug vars",
+				[]string{
+					http.MethodGet,
+				},
+				"/debug/vars",
+				rest.HandlerToRestFunc(expvar.Handler().ServeHTTP),
+			},
 		}...))
 }
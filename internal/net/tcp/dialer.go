@@ -55,17 +55,7 @@ func NewDialer(ctx context.Context, opts ...DialerOption) Dialer {
 		opt(d)
 	}
 
-	d.der = &net.Dialer{
-		Timeout:   d.dialerTimeout,
-		KeepAlive: d.dialerKeepAlive,
-		DualStack: d.dialerDualStack,
-		Control:   Control,
-	}
-
-	d.der.Resolver = &net.Resolver{
-		PreferGo: false,
-		Dial:     d.der.DialContext,
-	}
+	d.der = d.netDialer()
 
 	if !d.dnsCache || d.cache == nil {
 		return d.der.DialContext
@@ -81,6 +71,30 @@ func NewDialer(ctx context.Context, opts ...DialerOption) Dialer {
 	return d.cachedDialer
 }
 
+// NewResolver returns the resolver of the dialer built with the options.
+func NewResolver(opts ...DialerOption) *net.Resolver {
+	d := new(dialer)
+	for _, opt := range append(defaultDialerOptions, opts...) {
+		opt(d)
+	}
+	return d.netDialer().Resolver
+}
+
+func (d *dialer) netDialer() *net.Dialer {
+	der := &net.Dialer{
+		Timeout:   d.dialerTimeout,
+		KeepAlive: d.dialerKeepAlive,
+		DualStack: d.dialerDualStack,
+		Control:   Control,
+	}
+
+	der.Resolver = &net.Resolver{
+		PreferGo: false,
+		Dial:     der.DialContext,
+	}
+	return der
+}
+
 func (d *dialer) lookup(ctx context.Context, host string) (ips map[int]string, err error) {
 	cache, ok := d.cache.Get(host)
 	if ok {
@@ -0,0 +1,134 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package placement provides the consistent hashing placement of the uuids on the agents
+package placement
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Placement decides which agents own an uuid by consistent hashing over the agent addresses.
+type Placement interface {
+	Update(addrs []string)
+	// Owners returns the addresses of the agents owning the uuid, the primary one first.
+	Owners(uuid string) []string
+	Len() int
+}
+
+type placement struct {
+	mu       sync.RWMutex
+	vnodes   int
+	replicas int
+	ring     []uint64
+	nodes    map[uint64]string
+	addrs    []string
+}
+
+func New(opts ...Option) Placement {
+	p := new(placement)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(p)
+	}
+	return p
+}
+
+func (p *placement) Update(addrs []string) {
+	ring := make([]uint64, 0, len(addrs)*p.vnodes)
+	nodes := make(map[uint64]string, len(addrs)*p.vnodes)
+	for _, addr := range addrs {
+		for i := 0; i < p.vnodes; i++ {
+			h := hash(addr + "#" + strconv.Itoa(i))
+			if _, ok := nodes[h]; ok {
+				continue
+			}
+			nodes[h] = addr
+			ring = append(ring, h)
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool {
+		return ring[i] < ring[j]
+	})
+
+	p.mu.Lock()
+	p.ring = ring
+	p.nodes = nodes
+	p.addrs = append(addrs[:0:0], addrs...)
+	p.mu.Unlock()
+}
+
+// Owners walks the ring clockwise from the uuid and collects the first replicas distinct agents.
+func (p *placement) Owners(uuid string) []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.ring) == 0 {
+		return nil
+	}
+
+	n := p.replicas
+	if n > len(p.addrs) {
+		n = len(p.addrs)
+	}
+	owners := make([]string, 0, n)
+	h := hash(uuid)
+	start := sort.Search(len(p.ring), func(i int) bool {
+		return p.ring[i] >= h
+	})
+	for i := 0; i < len(p.ring) && len(owners) < n; i++ {
+		addr := p.nodes[p.ring[(start+i)%len(p.ring)]]
+		if !contains(owners, addr) {
+			owners = append(owners, addr)
+		}
+	}
+	return owners
+}
+
+func (p *placement) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.addrs)
+}
+
+// hash spreads the fnv hash of s with the splitmix64 finalizer, as fnv alone clusters the similar virtual node keys.
+func hash(s string) uint64 {
+	f := fnv.New64a()
+	f.Write([]byte(s))
+	h := f.Sum64()
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package placement provides the consistent hashing placement of the uuids on the agents
+package placement
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func agentAddrs(n int) []string {
+	addrs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		addrs = append(addrs, "10.0.0."+strconv.Itoa(i)+":8082")
+	}
+	return addrs
+}
+
+func TestPlacementOwnersAreDeterministic(t *testing.T) {
+	p1 := New(WithReplicas(3))
+	p1.Update(agentAddrs(5))
+	addrs := agentAddrs(5)
+	addrs[0], addrs[4] = addrs[4], addrs[0]
+	p2 := New(WithReplicas(3))
+	p2.Update(addrs)
+
+	for i := 0; i < 1000; i++ {
+		uuid := "uuid-" + strconv.Itoa(i)
+		o1, o2 := p1.Owners(uuid), p2.Owners(uuid)
+		if !reflect.DeepEqual(o1, o2) {
+			t.Fatalf("TestPlacementOwnersAreDeterministic: %v, wanted: %v", o2, o1)
+		}
+		if len(o1) != 3 {
+			t.Fatalf("TestPlacementOwnersAreDeterministic: %d owners, wanted: 3", len(o1))
+		}
+		seen := make(map[string]struct{})
+		for _, addr := range o1 {
+			if _, ok := seen[addr]; ok {
+				t.Fatalf("TestPlacementOwnersAreDeterministic: duplicated owner %s in %v", addr, o1)
+			}
+			seen[addr] = struct{}{}
+		}
+	}
+}
+
+func TestPlacementReplicasAreCappedByAgents(t *testing.T) {
+	p := New(WithReplicas(3))
+	if owners := p.Owners("uuid"); owners != nil {
+		t.Errorf("TestPlacementReplicasAreCappedByAgents: %v, wanted: nil", owners)
+	}
+	p.Update(agentAddrs(2))
+	if owners := p.Owners("uuid"); len(owners) != 2 {
+		t.Errorf("TestPlacementReplicasAreCappedByAgents: %v, wanted 2 owners", owners)
+	}
+}
+
+func TestPlacementBalancesAndMovesFewUUIDs(t *testing.T) {
+	const n = 10000
+	p := New()
+	p.Update(agentAddrs(4))
+
+	before := make([]string, n)
+	counts := make(map[string]int)
+	for i := range before {
+		before[i] = p.Owners("uuid-" + strconv.Itoa(i))[0]
+		counts[before[i]]++
+	}
+	for addr, c := range counts {
+		if c < n/4/2 || c > n/4*2 {
+			t.Errorf("TestPlacementBalancesAndMovesFewUUIDs: %s owns %d of %d uuids", addr, c, n)
+		}
+	}
+
+	p.Update(agentAddrs(5))
+	var moved int
+	for i := range before {
+		if p.Owners("uuid-" + strconv.Itoa(i))[0] != before[i] {
+			moved++
+		}
+	}
+	// adding the fifth agent should move only about a fifth of the uuids
+	if moved > n/5*2 {
+		t.Errorf("TestPlacementBalancesAndMovesFewUUIDs: %d of %d uuids moved", moved, n)
+	}
+}
@@ -0,0 +1,47 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package traffic provides the token bucket rate limits and the quotas of the tenants
+package traffic
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/apis/grpc/traffic_manager"
+	"google.golang.org/grpc"
+)
+
+type client struct {
+	tc traffic_manager.TrafficClient
+}
+
+// NewClient returns the Limiter consulting the traffic manager, so that the gateways share the limits of the tenants.
+func NewClient(conn *grpc.ClientConn) Limiter {
+	return &client{
+		tc: traffic_manager.NewTrafficClient(conn),
+	}
+}
+
+func (c *client) Acquire(ctx context.Context, req *payload.Traffic_Request) (*payload.Traffic_Response, error) {
+	return c.tc.Acquire(ctx, req)
+}
@@ -0,0 +1,208 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package traffic provides the token bucket rate limits and the quotas of the tenants
+package traffic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// APIKeyMetadataKey is the gRPC metadata key of the api key.
+	APIKeyMetadataKey = "x-api-key"
+
+	// APIKeyHeader is the HTTP header of the api key.
+	APIKeyHeader = "X-Api-Key"
+)
+
+// UnaryServerInterceptor rejects the requests exceeding the limits with RESOURCE_EXHAUSTED and the RetryInfo detail.
+func UnaryServerInterceptor(l Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		err := acquire(ctx, l, apiKey(ctx), info.FullMethod, objects(req))
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor takes each message received from the stream as a request.
+func StreamServerInterceptor(l Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{
+			ServerStream: ss,
+			limiter:      l,
+			key:          apiKey(ss.Context()),
+			method:       info.FullMethod,
+		})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	limiter Limiter
+	key     string
+	method  string
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	return acquire(s.Context(), s.limiter, s.key, s.method, objects(m))
+}
+
+// Handler rejects the HTTP requests exceeding the limits with 429 and the Retry-After header.
+func Handler(l Limiter, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := acquire(r.Context(), l, r.Header.Get(APIKeyHeader), r.URL.Path, restObjects(r))
+		if err == nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+		st := status.Convert(err)
+		code := http.StatusTooManyRequests
+		if st.Code() == codes.Unauthenticated {
+			code = http.StatusUnauthorized
+		}
+		if d := RetryAfter(err); d > 0 {
+			w.Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10))
+		}
+		http.Error(w, st.Message(), code)
+	})
+}
+
+// RetryAfter returns the retry delay of the RetryInfo detail of the gRPC error.
+func RetryAfter(err error) time.Duration {
+	for _, d := range status.Convert(err).Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok {
+			d, err := ptypes.Duration(ri.GetRetryDelay())
+			if err == nil {
+				return d
+			}
+		}
+	}
+	return 0
+}
+
+// acquire returns the gRPC error of the denied request. The request is allowed when the limiter fails,
+// so that the traffic manager being unavailable does not stop the service.
+func acquire(ctx context.Context, l Limiter, key, method string, n uint64) error {
+	res, err := l.Acquire(ctx, &payload.Traffic_Request{
+		ApiKey:  key,
+		Method:  method,
+		Objects: n,
+	})
+	if err != nil {
+		if err == errors.ErrTrafficUnknownAPIKey || status.Code(err) == codes.Unauthenticated {
+			return status.Error(codes.Unauthenticated, errors.ErrTrafficUnknownAPIKey.Error())
+		}
+		log.Warnf("traffic limiter failed, %s is allowed: %v", method, err)
+		return nil
+	}
+	if res.GetAllowed() {
+		return nil
+	}
+	return exhausted(res).Err()
+}
+
+func exhausted(res *payload.Traffic_Response) *status.Status {
+	st := status.New(codes.ResourceExhausted, errors.ErrTrafficLimited(res.GetTenant(), res.GetReason()).Error())
+	if res.GetRetryAfter() <= 0 {
+		return st
+	}
+	dst, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: ptypes.DurationProto(time.Duration(res.GetRetryAfter())),
+	})
+	if err != nil {
+		return st
+	}
+	return dst
+}
+
+func apiKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if keys := md.Get(APIKeyMetadataKey); len(keys) != 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// objects returns the number of the objects the request writes.
+func objects(req interface{}) uint64 {
+	switch r := req.(type) {
+	case *payload.Object_Vector:
+		return 1
+	case *payload.Object_Vectors:
+		return uint64(len(r.GetVectors()))
+	}
+	return 0
+}
+
+// restObjects returns the number of the objects the REST request writes, decoding the body of the multiple writes
+// and leaving it readable for the handler.
+func restObjects(r *http.Request) uint64 {
+	switch r.URL.Path {
+	case "/insert", "/update":
+		return 1
+	case "/insert/multi", "/update/multi":
+	default:
+		return 0
+	}
+	if r.Body == nil {
+		return 0
+	}
+	b, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return 0
+	}
+	var req *payload.Object_Vectors
+	err = json.Unmarshal(b, &req)
+	if err != nil {
+		return 0
+	}
+	return objects(req)
+}
@@ -0,0 +1,70 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package traffic provides the token bucket rate limits and the quotas of the tenants
+package traffic
+
+import (
+	"math"
+	"time"
+)
+
+type Option func(*limiter)
+
+var (
+	defaultOpts = []Option{
+		WithClock(time.Now),
+	}
+)
+
+// WithTenant adds the tenant of the api keys, the burst defaults to the requests per second.
+func WithTenant(name string, limit Limit, keys ...string) Option {
+	return func(l *limiter) {
+		if limit.Burst <= 0 {
+			limit.Burst = int(math.Max(1, math.Ceil(limit.RequestsPerSecond)))
+		}
+		l.tenants[name] = &tenant{
+			name:   name,
+			limit:  limit,
+			tokens: float64(limit.Burst),
+		}
+		for _, key := range keys {
+			l.keys[key] = name
+		}
+	}
+}
+
+// WithDefaultTenant sets the tenant of the requests whose api key belongs to no tenant.
+func WithDefaultTenant(name string) Option {
+	return func(l *limiter) {
+		l.defaultTenant = name
+	}
+}
+
+func WithClock(now func() time.Time) Option {
+	return func(l *limiter) {
+		if now == nil {
+			return
+		}
+		l.now = now
+	}
+}
@@ -0,0 +1,156 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package traffic provides the token bucket rate limits and the quotas of the tenants
+package traffic
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Limiter decides whether the request of the api key is allowed under the limits of its tenant.
+type Limiter interface {
+	// Acquire takes the request and the objects it writes from the tenant of the api key,
+	// and returns the denied response with the retry hint when the tenant exceeds its limits.
+	// It fails with errors.ErrTrafficUnknownAPIKey when the api key belongs to no tenant and no default tenant is configured.
+	Acquire(ctx context.Context, req *payload.Traffic_Request) (*payload.Traffic_Response, error)
+}
+
+// Limit represents the limits of a tenant, the zero values are unlimited.
+type Limit struct {
+	// RequestsPerSecond is the refill rate of the token bucket, each request takes a token.
+	RequestsPerSecond float64
+	// Burst is the size of the token bucket.
+	Burst int
+	// RequestQuota is the number of the requests allowed in the quota duration.
+	RequestQuota uint64
+	// ObjectQuota is the number of the objects written in the quota duration.
+	ObjectQuota uint64
+	// QuotaDuration is the fixed window of the quotas, the quotas never reset when it is 0.
+	QuotaDuration time.Duration
+}
+
+type limiter struct {
+	mu            sync.Mutex
+	keys          map[string]string
+	tenants       map[string]*tenant
+	defaultTenant string
+	now           func() time.Time
+}
+
+type tenant struct {
+	name  string
+	limit Limit
+
+	tokens   float64
+	last     time.Time
+	window   time.Time
+	requests uint64
+	objects  uint64
+}
+
+const (
+	reasonRateLimit    = "rate limit exceeded"
+	reasonRequestQuota = "request quota exceeded"
+	reasonObjectQuota  = "object quota exceeded"
+)
+
+func New(opts ...Option) Limiter {
+	l := &limiter{
+		keys:    make(map[string]string),
+		tenants: make(map[string]*tenant),
+	}
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(l)
+	}
+	return l
+}
+
+func (l *limiter) Acquire(ctx context.Context, req *payload.Traffic_Request) (*payload.Traffic_Response, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	name, ok := l.keys[req.GetApiKey()]
+	if !ok {
+		name = l.defaultTenant
+	}
+	t, ok := l.tenants[name]
+	if !ok {
+		return nil, errors.ErrTrafficUnknownAPIKey
+	}
+
+	reason, retry := t.acquire(l.now(), req.GetObjects())
+	return &payload.Traffic_Response{
+		Allowed:    reason == "",
+		Tenant:     t.name,
+		Reason:     reason,
+		RetryAfter: int64(retry),
+	}, nil
+}
+
+// acquire returns the reason and the retry delay when the request is denied, the denied request takes nothing.
+// The retry delay is 0 when the request is never allowed, such as after the lifetime quota is used up.
+func (t *tenant) acquire(now time.Time, objects uint64) (string, time.Duration) {
+	lim := t.limit
+
+	// the clock starts on the first request, so that it is read from the clock of the limiter whatever the order of the options
+	if t.last.IsZero() {
+		t.last, t.window = now, now
+	}
+
+	var reset time.Duration
+	if lim.QuotaDuration > 0 {
+		if elapsed := now.Sub(t.window); elapsed >= lim.QuotaDuration {
+			t.window = t.window.Add(elapsed - elapsed%lim.QuotaDuration)
+			t.requests, t.objects = 0, 0
+		}
+		reset = t.window.Add(lim.QuotaDuration).Sub(now)
+	}
+	if lim.RequestQuota > 0 && t.requests+1 > lim.RequestQuota {
+		return reasonRequestQuota, reset
+	}
+	if lim.ObjectQuota > 0 && t.objects+objects > lim.ObjectQuota {
+		if objects > lim.ObjectQuota {
+			return reasonObjectQuota, 0
+		}
+		return reasonObjectQuota, reset
+	}
+
+	if lim.RequestsPerSecond > 0 {
+		t.tokens = math.Min(float64(lim.Burst), t.tokens+now.Sub(t.last).Seconds()*lim.RequestsPerSecond)
+		t.last = now
+		if t.tokens < 1 {
+			return reasonRateLimit, time.Duration(math.Ceil((1 - t.tokens) / lim.RequestsPerSecond * float64(time.Second)))
+		}
+		t.tokens--
+	}
+
+	t.requests++
+	t.objects += objects
+	return "", 0
+}
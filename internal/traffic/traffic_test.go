@@ -0,0 +1,242 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package traffic provides the token bucket rate limits and the quotas of the tenants
+package traffic
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func acquireN(t *testing.T, l Limiter, key string, objects uint64, n int) *payload.Traffic_Response {
+	var res *payload.Traffic_Response
+	for i := 0; i < n; i++ {
+		var err error
+		res, err = l.Acquire(context.Background(), &payload.Traffic_Request{
+			ApiKey:  key,
+			Objects: objects,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: Acquire(%v)", err)
+		}
+	}
+	return res
+}
+
+func TestRateLimit(t *testing.T) {
+	c := &clock{now: time.Unix(0, 0)}
+	l := New(
+		WithClock(c.Now),
+		WithTenant("tenant", Limit{
+			RequestsPerSecond: 2,
+			Burst:             4,
+		}, "key"),
+	)
+
+	if res := acquireN(t, l, "key", 0, 4); !res.GetAllowed() {
+		t.Fatalf("TestRateLimit: %v, wanted the burst allowed", res)
+	}
+	res := acquireN(t, l, "key", 0, 1)
+	if res.GetAllowed() || res.GetTenant() != "tenant" || res.GetReason() != reasonRateLimit {
+		t.Fatalf("TestRateLimit: %v, wanted the rate limited response", res)
+	}
+	if wants := int64(500 * time.Millisecond); res.GetRetryAfter() != wants {
+		t.Errorf("TestRateLimit: %v, wanted: %v", res.GetRetryAfter(), wants)
+	}
+
+	c.Add(500 * time.Millisecond)
+	if res := acquireN(t, l, "key", 0, 1); !res.GetAllowed() {
+		t.Errorf("TestRateLimit: %v, wanted the refilled token allowed", res)
+	}
+	if res := acquireN(t, l, "key", 0, 1); res.GetAllowed() {
+		t.Errorf("TestRateLimit: %v, wanted the rate limited response", res)
+	}
+}
+
+func TestQuota(t *testing.T) {
+	c := &clock{now: time.Unix(0, 0)}
+	l := New(
+		WithTenant("tenant", Limit{
+			RequestQuota:  3,
+			ObjectQuota:   10,
+			QuotaDuration: time.Hour,
+		}, "key"),
+		WithClock(c.Now),
+	)
+
+	if res := acquireN(t, l, "key", 4, 2); !res.GetAllowed() {
+		t.Fatalf("TestQuota: %v, wanted allowed", res)
+	}
+	c.Add(15 * time.Minute)
+	res := acquireN(t, l, "key", 4, 1)
+	if res.GetAllowed() || res.GetReason() != reasonObjectQuota || res.GetRetryAfter() != int64(45*time.Minute) {
+		t.Errorf("TestQuota: %v, wanted the object quota exceeded until the window resets", res)
+	}
+	if res := acquireN(t, l, "key", 2, 1); !res.GetAllowed() {
+		t.Errorf("TestQuota: %v, wanted the objects within the quota allowed", res)
+	}
+	res = acquireN(t, l, "key", 0, 1)
+	if res.GetAllowed() || res.GetReason() != reasonRequestQuota {
+		t.Errorf("TestQuota: %v, wanted the request quota exceeded", res)
+	}
+
+	c.Add(time.Hour)
+	if res := acquireN(t, l, "key", 10, 1); !res.GetAllowed() {
+		t.Errorf("TestQuota: %v, wanted the quotas reset", res)
+	}
+	if res := acquireN(t, l, "key", 11, 1); res.GetAllowed() || res.GetRetryAfter() != 0 {
+		t.Errorf("TestQuota: %v, wanted the request over the whole quota never allowed", res)
+	}
+}
+
+func TestTenants(t *testing.T) {
+	l := New(
+		WithTenant("limited", Limit{RequestQuota: 1}, "key-1", "key-2"),
+		WithTenant("anonymous", Limit{}),
+	)
+	if res := acquireN(t, l, "key-1", 0, 1); !res.GetAllowed() {
+		t.Errorf("TestTenants: %v, wanted allowed", res)
+	}
+	if res := acquireN(t, l, "key-2", 0, 1); res.GetAllowed() || res.GetTenant() != "limited" {
+		t.Errorf("TestTenants: %v, wanted the quota shared by the keys of the tenant", res)
+	}
+	_, err := l.Acquire(context.Background(), &payload.Traffic_Request{ApiKey: "unknown"})
+	if err != errors.ErrTrafficUnknownAPIKey {
+		t.Errorf("TestTenants: %v, wanted: %v", err, errors.ErrTrafficUnknownAPIKey)
+	}
+
+	l = New(
+		WithTenant("anonymous", Limit{}),
+		WithDefaultTenant("anonymous"),
+	)
+	if res := acquireN(t, l, "unknown", 0, 1); !res.GetAllowed() || res.GetTenant() != "anonymous" {
+		t.Errorf("TestTenants: %v, wanted the default tenant", res)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l := New(
+		WithClock((&clock{now: time.Unix(0, 0)}).Now),
+		WithTenant("tenant", Limit{RequestsPerSecond: 1}, "key"),
+	)
+	intercept := UnaryServerInterceptor(l)
+	info := &grpc.UnaryServerInfo{FullMethod: "/vald.Vald/Insert"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return new(payload.Common_Error), nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadataKey, "key"))
+	if _, err := intercept(ctx, new(payload.Object_Vector), info, handler); err != nil {
+		t.Fatalf("Unexpected error: TestUnaryServerInterceptor(%v)", err)
+	}
+	_, err := intercept(ctx, new(payload.Object_Vector), info, handler)
+	if status.Code(err) != codes.ResourceExhausted || RetryAfter(err) != time.Second {
+		t.Errorf("TestUnaryServerInterceptor: %v retry after %v, wanted RESOURCE_EXHAUSTED retry after 1s", err, RetryAfter(err))
+	}
+
+	_, err = intercept(context.Background(), new(payload.Object_Vector), info, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("TestUnaryServerInterceptor: %v, wanted UNAUTHENTICATED", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	l := New(
+		WithClock((&clock{now: time.Unix(0, 0)}).Now),
+		WithTenant("tenant", Limit{RequestsPerSecond: 0.5}, "key"),
+	)
+	h := Handler(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, wants := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodPost, "/insert", nil)
+		req.Header.Set(APIKeyHeader, "key")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != wants {
+			t.Errorf("TestHandler: %d, wanted: %d", w.Code, wants)
+		}
+		if wants == http.StatusTooManyRequests && w.Header().Get("Retry-After") != "2" {
+			t.Errorf("TestHandler: Retry-After %s, wanted: 2", w.Header().Get("Retry-After"))
+		}
+	}
+}
+
+func TestHandlerObjects(t *testing.T) {
+	l := New(
+		WithTenant("tenant", Limit{ObjectQuota: 3}, "key"),
+	)
+	var body string
+	h := Handler(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, tc := range []struct {
+		path  string
+		body  string
+		wants int
+	}{
+		{"/insert/multi", `{"vectors":[{"id":{"id":"a"},"vector":[0.1]},{"id":{"id":"b"},"vector":[0.2]}]}`, http.StatusOK},
+		{"/insert", `{"id":{"id":"c"},"vector":[0.3]}`, http.StatusOK},
+		{"/update", `{"id":{"id":"c"},"vector":[0.4]}`, http.StatusTooManyRequests},
+		{"/search", `{"vector":[0.1]}`, http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+		req.Header.Set(APIKeyHeader, "key")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != tc.wants {
+			t.Errorf("TestHandlerObjects: %s %d, wanted: %d", tc.path, w.Code, tc.wants)
+		}
+		if w.Code == http.StatusOK && body != tc.body {
+			t.Errorf("TestHandlerObjects: %s body %s, wanted: %s", tc.path, body, tc.body)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package wal provides append-only write-ahead log for index mutations
+package wal
+
+import (
+	"strings"
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type Option func(*wal)
+
+var (
+	defaultOpts = []Option{
+		WithSyncPolicy("always"),
+		WithSyncInterval("1s"),
+	}
+)
+
+func WithPath(path string) Option {
+	return func(w *wal) {
+		w.path = path
+	}
+}
+
+// WithSyncPolicy sets fsync policy, always, interval or none
+func WithSyncPolicy(policy string) Option {
+	return func(w *wal) {
+		switch strings.ToLower(policy) {
+		case "always", "":
+			w.policy = syncAlways
+		case "interval", "periodic":
+			w.policy = syncInterval
+		case "none", "never", "os":
+			w.policy = syncNone
+		}
+	}
+}
+
+func WithSyncInterval(dur string) Option {
+	return func(w *wal) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			d = time.Second
+		}
+		w.interval = d
+	}
+}
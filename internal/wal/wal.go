@@ -0,0 +1,326 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package wal provides append-only write-ahead log for index mutations
+package wal
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Op is the kind of mutation recorded in the log
+type Op uint8
+
+const (
+	// OpNone is unknown operation
+	OpNone Op = iota
+	// OpInsert is insert operation
+	OpInsert
+	// OpUpdate is update operation
+	OpUpdate
+	// OpDelete is delete operation
+	OpDelete
+)
+
+// Entry is a mutation record
+type Entry struct {
+	Op     Op
+	UUID   string
+	Vector []float64
+}
+
+// WAL is write-ahead log interface
+type WAL interface {
+	// Append writes the entry to the end of the log.
+	Append(e *Entry) error
+
+	// Replay calls f for every valid entry from the head of the log.
+	// A torn or corrupted tail is cut off.
+	Replay(f func(*Entry) error) error
+
+	// Truncate discards all entries.
+	Truncate() error
+
+	// Sync flushes the log to storage.
+	Sync() error
+
+	// Close stops the sync daemon and closes the log file.
+	Close() error
+}
+
+type wal struct {
+	mu       sync.Mutex
+	path     string
+	policy   syncPolicy
+	interval time.Duration
+	file     *os.File
+	dirty    bool
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+type syncPolicy int
+
+const (
+	// syncAlways calls fsync on every append
+	syncAlways syncPolicy = iota
+	// syncInterval calls fsync periodically
+	syncInterval
+	// syncNone leaves flushing to the OS
+	syncNone
+)
+
+const (
+	// headerSize is the size of the record header, 4 bytes payload length and 4 bytes crc32c checksum
+	headerSize = 8
+
+	// maxPayloadSize limits a single record size to detect corrupted length field
+	maxPayloadSize = 1 << 30
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// New returns WAL opening or creating the log file
+func New(opts ...Option) (WAL, error) {
+	w := new(wal)
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(w)
+	}
+
+	f, err := os.OpenFile(w.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	w.file = f
+
+	if w.policy == syncInterval && w.interval > 0 {
+		w.startSyncDaemon()
+	}
+
+	return w, nil
+}
+
+func (w *wal) startSyncDaemon() {
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(context.Background())
+	w.done = make(chan struct{})
+	go func() {
+		defer close(w.done)
+		tick := time.NewTicker(w.interval)
+		defer tick.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tick.C:
+				err := safety.RecoverFunc(w.Sync)()
+				if err != nil {
+					log.Error(err)
+				}
+			}
+		}
+	}()
+}
+
+func (w *wal) Append(e *Entry) error {
+	payload := encode(e)
+	buf := make([]byte, headerSize+len(payload))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(payload, crcTable))
+	copy(buf[headerSize:], payload)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.file.Write(buf)
+	if err != nil {
+		return errors.ErrWALAppendFailed(err, w.path)
+	}
+
+	if w.policy == syncAlways {
+		return w.file.Sync()
+	}
+	w.dirty = true
+	return nil
+}
+
+func (w *wal) Replay(f func(*Entry) error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	var (
+		r      = bufio.NewReader(w.file)
+		header = make([]byte, headerSize)
+		offset int64
+	)
+
+	for {
+		_, err = io.ReadFull(r, header)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return w.cut(offset, err)
+		}
+
+		size := binary.LittleEndian.Uint32(header[0:4])
+		if size > maxPayloadSize {
+			return w.cut(offset, errors.ErrWALCorrupted(offset))
+		}
+
+		payload := make([]byte, size)
+		_, err = io.ReadFull(r, payload)
+		if err != nil {
+			return w.cut(offset, err)
+		}
+
+		if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+			return w.cut(offset, errors.ErrWALCorrupted(offset))
+		}
+
+		e, err := decode(payload)
+		if err != nil {
+			return w.cut(offset, err)
+		}
+
+		err = f(e)
+		if err != nil {
+			return err
+		}
+
+		offset += int64(headerSize) + int64(size)
+	}
+}
+
+// cut truncates the torn or corrupted tail which starts from offset.
+func (w *wal) cut(offset int64, cause error) error {
+	log.Warn(errors.ErrWALTailTruncated(cause, w.path, offset))
+	err := w.file.Truncate(offset)
+	if err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+func (w *wal) Truncate() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	err := w.file.Truncate(0)
+	if err != nil {
+		return err
+	}
+	w.dirty = false
+	return w.file.Sync()
+}
+
+func (w *wal) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.dirty {
+		return nil
+	}
+	w.dirty = false
+	return w.file.Sync()
+}
+
+func (w *wal) Close() error {
+	if w.cancel != nil {
+		w.cancel()
+		<-w.done
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	err := w.file.Sync()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// encode serializes the entry as op | uvarint(len(uuid)) | uuid | uvarint(len(vector)) | float64 bits...
+func encode(e *Entry) []byte {
+	buf := make([]byte, 1+binary.MaxVarintLen64*2+len(e.UUID)+len(e.Vector)*8)
+	buf[0] = byte(e.Op)
+	n := 1
+	n += binary.PutUvarint(buf[n:], uint64(len(e.UUID)))
+	n += copy(buf[n:], e.UUID)
+	n += binary.PutUvarint(buf[n:], uint64(len(e.Vector)))
+	for _, v := range e.Vector {
+		binary.LittleEndian.PutUint64(buf[n:], math.Float64bits(v))
+		n += 8
+	}
+	return buf[:n]
+}
+
+func decode(payload []byte) (*Entry, error) {
+	if len(payload) < 1 {
+		return nil, errors.ErrWALInvalidEntry
+	}
+	e := &Entry{
+		Op: Op(payload[0]),
+	}
+	n := 1
+
+	l, m := binary.Uvarint(payload[n:])
+	if m <= 0 || uint64(len(payload)-n-m) < l {
+		return nil, errors.ErrWALInvalidEntry
+	}
+	n += m
+	e.UUID = string(payload[n : n+int(l)])
+	n += int(l)
+
+	l, m = binary.Uvarint(payload[n:])
+	if m <= 0 || uint64(len(payload)-n-m) != l*8 {
+		return nil, errors.ErrWALInvalidEntry
+	}
+	n += m
+	if l > 0 {
+		e.Vector = make([]float64, l)
+		for i := range e.Vector {
+			e.Vector[i] = math.Float64frombits(binary.LittleEndian.Uint64(payload[n:]))
+			n += 8
+		}
+	}
+
+	return e, nil
+}
@@ -0,0 +1,159 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package wal provides append-only write-ahead log for index mutations
+package wal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/internal/log"
+)
+
+func TestReplay(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	tests := []*Entry{
+		{OpInsert, "uuid-1", []float64{1, 0, 0, 0, 0, 0}},
+		{OpInsert, "uuid-2", []float64{0, 1, 0, 0, 0, 0}},
+		{OpUpdate, "uuid-1", []float64{0, 0, 1, 0, 0, 0}},
+		{OpDelete, "uuid-2", nil},
+	}
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestReplay(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "wal")
+
+	w, err := New(WithPath(path))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReplay(%v)", err)
+	}
+	for _, tt := range tests {
+		if err := w.Append(tt); err != nil {
+			t.Errorf("Unexpected error: TestReplay(%v)", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("Unexpected error: TestReplay(%v)", err)
+	}
+
+	// simulate a torn write at the tail
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReplay(%v)", err)
+	}
+	f.Write([]byte{0xff, 0x00, 0x00})
+	f.Close()
+
+	w, err = New(WithPath(path), WithSyncPolicy("interval"), WithSyncInterval("10ms"))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReplay(%v)", err)
+	}
+	defer w.Close()
+
+	got := make([]*Entry, 0, len(tests))
+	err = w.Replay(func(e *Entry) error {
+		got = append(got, e)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: TestReplay(%v)", err)
+	}
+	if !reflect.DeepEqual(got, tests) {
+		t.Errorf("TestReplay: %v, wanted: %v", got, tests)
+	}
+
+	if err := w.Truncate(); err != nil {
+		t.Errorf("Unexpected error: TestReplay(%v)", err)
+	}
+	err = w.Replay(func(e *Entry) error {
+		t.Errorf("TestReplay: unexpected entry %v after truncate", e)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: TestReplay(%v)", err)
+	}
+}
+
+func TestReplayCorrupted(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Errorf("Unexpected error: TestReplayCorrupted(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "wal")
+
+	w, err := New(WithPath(path), WithSyncPolicy("none"))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReplayCorrupted(%v)", err)
+	}
+	w.Append(&Entry{OpInsert, "uuid-1", []float64{1, 2, 3}})
+	w.Append(&Entry{OpInsert, "uuid-2", []float64{4, 5, 6}})
+	w.Close()
+
+	// flip the last byte of the second record
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReplayCorrupted(%v)", err)
+	}
+	b[len(b)-1] ^= 0xff
+	if err := ioutil.WriteFile(path, b, 0600); err != nil {
+		t.Fatalf("Unexpected error: TestReplayCorrupted(%v)", err)
+	}
+
+	w, err = New(WithPath(path))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReplayCorrupted(%v)", err)
+	}
+	defer w.Close()
+
+	var uuids []string
+	err = w.Replay(func(e *Entry) error {
+		uuids = append(uuids, e.UUID)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: TestReplayCorrupted(%v)", err)
+	}
+	if !reflect.DeepEqual(uuids, []string{"uuid-1"}) {
+		t.Errorf("TestReplayCorrupted: %v, wanted: %v", uuids, []string{"uuid-1"})
+	}
+
+	w.Append(&Entry{OpDelete, "uuid-1", nil})
+	uuids = uuids[:0]
+	w.Replay(func(e *Entry) error {
+		uuids = append(uuids, e.UUID)
+		return nil
+	})
+	if !reflect.DeepEqual(uuids, []string{"uuid-1", "uuid-1"}) {
+		t.Errorf("TestReplayCorrupted: %v, wanted: %v", uuids, []string{"uuid-1", "uuid-1"})
+	}
+}
@@ -38,6 +38,12 @@ type Data struct {
 
 	// NGT represent ngt core configuration
 	NGT *config.NGT `json:"ngt" yaml:"ngt"`
+
+	// Indexes represent the named ngt core configurations served alongside the default one
+	Indexes []*config.NGT `json:"indexes" yaml:"indexes"`
+
+	// ImportDir represent the directory the Import requests read the datasets from, Import is disabled when it is empty
+	ImportDir string `json:"import_dir" yaml:"import_dir"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -53,6 +59,12 @@ func NewConfig(path string) (cfg *Data, err error) {
 	if cfg.NGT != nil {
 		cfg.NGT = cfg.NGT.Bind()
 	}
+	for i, idx := range cfg.Indexes {
+		if idx != nil {
+			cfg.Indexes[i] = idx.Bind()
+		}
+	}
+	cfg.ImportDir = config.GetActualValue(cfg.ImportDir)
 
 	return cfg, nil
 }
@@ -24,25 +24,42 @@
 package grpc
 
 import (
+	"bufio"
 	"context"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/vdaas/vald/apis/grpc/agent"
 	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/dataset"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/pkg/agent/ngt/model"
 	"github.com/vdaas/vald/pkg/agent/ngt/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Server agent.AgentServer
 
+const (
+	// snapshotChunkSize is the size of the data in each Snapshot chunk
+	snapshotChunkSize = 1 << 20
+
+	// minVectorSize is the size either vector or float32_vector of payload.Object_Vector has at least
+	minVectorSize = 2
+)
+
 type server struct {
-	ngt service.NGT
+	ngts      map[string]service.NGT
+	importDir string
 }
 
 func New(opts ...Option) Server {
-	s := new(server)
+	s := &server{
+		ngts: make(map[string]service.NGT),
+	}
 
 	for _, opt := range append(defaultOpts, opts...) {
 		opt(s)
@@ -50,8 +67,21 @@ func New(opts ...Option) Server {
 	return s
 }
 
+// index returns the NGT service of the named index, the empty name is the default index.
+func (s *server) index(name string) (service.NGT, error) {
+	n, ok := s.ngts[name]
+	if !ok {
+		return nil, errors.ErrNamedIndexNotFound(name)
+	}
+	return n, nil
+}
+
 func (s *server) Exists(ctx context.Context, oid *payload.Object_ID) (*payload.Object_ID, error) {
-	id, ok := s.ngt.Exists(oid.GetId())
+	n, err := s.index(oid.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	id, ok := n.Exists(oid.GetId())
 	if !ok {
 		return nil, errors.ErrObjectIDNotFound(oid.GetId())
 	}
@@ -61,21 +91,138 @@ func (s *server) Exists(ctx context.Context, oid *payload.Object_ID) (*payload.O
 }
 
 func (s *server) Search(ctx context.Context, req *payload.Search_Request) (*payload.Search_Response, error) {
+	n, err := s.index(req.GetConfig().GetIndex())
+	if err != nil {
+		return toSearchResponse(nil, err)
+	}
+	if req.GetConfig().GetExact() {
+		return toSearchResponse(
+			n.ExactSearch(
+				toFloat64Vector(req.GetVector()),
+				req.GetConfig().GetNum(),
+				toFilter(req.GetConfig())))
+	}
+	if vec := req.GetVector().GetFloat32Vector(); len(vec) != 0 {
+		return toSearchResponse(
+			n.SearchFloat32(
+				vec,
+				req.GetConfig().GetNum(),
+				req.GetConfig().GetEpsilon(),
+				req.GetConfig().GetRadius(),
+				toFilter(req.GetConfig())))
+	}
 	return toSearchResponse(
-		s.ngt.Search(
+		n.Search(
 			req.GetVector().GetVector(),
 			req.GetConfig().GetNum(),
 			req.GetConfig().GetEpsilon(),
-			req.GetConfig().GetRadius()))
+			req.GetConfig().GetRadius(),
+			toFilter(req.GetConfig())))
 }
 
 func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error) {
+	n, err := s.index(req.GetConfig().GetIndex())
+	if err != nil {
+		return toSearchResponse(nil, err)
+	}
+	if req.GetConfig().GetExact() {
+		vec, err := n.GetObject(req.GetId().GetId())
+		if err != nil {
+			return toSearchResponse(nil, err)
+		}
+		return toSearchResponse(
+			n.ExactSearch(
+				vec,
+				req.GetConfig().GetNum(),
+				toFilter(req.GetConfig())))
+	}
 	return toSearchResponse(
-		s.ngt.SearchByID(
+		n.SearchByID(
 			req.GetId().GetId(),
 			req.GetConfig().GetNum(),
 			req.GetConfig().GetEpsilon(),
-			req.GetConfig().GetRadius()))
+			req.GetConfig().GetRadius(),
+			toFilter(req.GetConfig())))
+}
+
+func (s *server) MultiSearch(ctx context.Context, req *payload.Search_MultiRequest) (*payload.Search_Responses, error) {
+	n, err := s.index(req.GetConfig().GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	vecs := make([][]float64, 0, len(req.GetVectors()))
+	for _, vec := range req.GetVectors() {
+		vecs = append(vecs, toFloat64Vector(vec))
+	}
+
+	res := &payload.Search_Responses{
+		Responses: make([]*payload.Search_Response, 0, len(vecs)),
+	}
+
+	filter := toFilter(req.GetConfig())
+	if req.GetConfig().GetExact() {
+		for _, vec := range vecs {
+			r, _ := toSearchResponse(n.ExactSearch(vec, req.GetConfig().GetNum(), filter))
+			res.Responses = append(res.Responses, r)
+		}
+		return res, nil
+	}
+
+	dists, errs := n.BulkSearch(
+		vecs,
+		req.GetConfig().GetNum(),
+		req.GetConfig().GetEpsilon(),
+		req.GetConfig().GetRadius(),
+		filter)
+
+	for i := range dists {
+		r, _ := toSearchResponse(dists[i], errs[i])
+		res.Responses = append(res.Responses, r)
+	}
+
+	return res, nil
+}
+
+// toFilter returns the uuid filter of the search config, or nil when it has no filter.
+func toFilter(cfg *payload.Search_Config) *model.Filter {
+	if len(cfg.GetIncludeIds()) == 0 && len(cfg.GetExcludeIds()) == 0 {
+		return nil
+	}
+	f := &model.Filter{
+		Includes: make(map[string]struct{}, len(cfg.GetIncludeIds())),
+		Excludes: make(map[string]struct{}, len(cfg.GetExcludeIds())),
+	}
+	for _, id := range cfg.GetIncludeIds() {
+		f.Includes[id] = struct{}{}
+	}
+	for _, id := range cfg.GetExcludeIds() {
+		f.Excludes[id] = struct{}{}
+	}
+	return f
+}
+
+// validVector checks the size of payload.Object_Vector, which the proto cannot declare over vector and float32_vector.
+func validVector(vec *payload.Object_Vector) error {
+	size := len(vec.GetVector())
+	if fs := len(vec.GetFloat32Vector()); fs > size {
+		size = fs
+	}
+	if size < minVectorSize {
+		return errors.ErrVectorTooShort(size, minVectorSize)
+	}
+	return nil
+}
+
+// toFloat64Vector returns the float64 vector, widening the float32 vector when only it is set.
+func toFloat64Vector(vec *payload.Object_Vector) []float64 {
+	v := vec.GetVector()
+	if fv := vec.GetFloat32Vector(); len(v) == 0 && len(fv) != 0 {
+		v = make([]float64, len(fv))
+		for i, f := range fv {
+			v[i] = float64(f)
+		}
+	}
+	return v
 }
 
 func toSearchResponse(dists []model.Distance, err error) (*payload.Search_Response, error) {
@@ -118,12 +265,31 @@ func (s *server) StreamSearchByID(stream agent.Agent_StreamSearchByIDServer) err
 }
 
 func (s *server) Insert(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
-	err := s.ngt.Insert(vec.GetId().GetId(), vec.GetVector())
+	uuid := vec.GetId().GetId()
+	err := validVector(vec)
 	if err != nil {
-		return &payload.Common_Error{
+		return toError(uuid, err), err
+	}
+	n, err := s.index(vec.GetIndex())
+	if err == nil {
+		if fv := vec.GetFloat32Vector(); len(fv) != 0 {
+			err = n.InsertFloat32(uuid, fv)
+		} else {
+			err = n.Insert(uuid, vec.GetVector())
+		}
+	}
+	if err != nil {
+		cerr := &payload.Common_Error{
 			Msg:       err.Error(),
 			Timestamp: time.Now().UnixNano(),
-		}, err
+		}
+		// the gateway tells the uuid inserted before from the failures by the code
+		if n != nil {
+			if _, ok := n.Exists(uuid); ok {
+				return cerr, status.Error(codes.AlreadyExists, err.Error())
+			}
+		}
+		return cerr, err
 	}
 	return nil, nil
 }
@@ -134,20 +300,65 @@ func (s *server) StreamInsert(stream agent.Agent_StreamInsertServer) error {
 	})
 }
 
-func (s *server) MultiInsert(ctx context.Context, vecs *payload.Object_Vectors) (res *payload.Common_Errors, err error) {
-	res = new(payload.Common_Errors)
+// MultiInsert bulk-inserts the vectors of each index and returns the errors of the failed uuids in the response,
+// the call itself fails only when the response cannot be made.
+func (s *server) MultiInsert(ctx context.Context, vecs *payload.Object_Vectors) (*payload.Common_Errors, error) {
+	res := new(payload.Common_Errors)
+	reqs := make(map[string]map[string][]float64)
 	for _, vec := range vecs.GetVectors() {
-		r, ierr := s.Insert(ctx, vec)
-		if ierr != nil {
-			err = errors.Wrap(err, ierr.Error())
-			res.Errors = append(res.Errors, r)
+		uuid := vec.GetId().GetId()
+		m, ok := reqs[vec.GetIndex()]
+		if !ok {
+			m = make(map[string][]float64)
+			reqs[vec.GetIndex()] = m
+		}
+		if err := validVector(vec); err != nil {
+			res.Errors = append(res.Errors, toError(uuid, err))
+			continue
+		}
+		if _, ok := m[uuid]; ok {
+			res.Errors = append(res.Errors, toError(uuid, errors.ErrUUIDDuplicated(uuid)))
+			continue
 		}
+		m[uuid] = toFloat64Vector(vec)
+	}
+
+	for name, m := range reqs {
+		n, err := s.index(name)
+		if err != nil {
+			for uuid := range m {
+				res.Errors = append(res.Errors, toError(uuid, err))
+			}
+			continue
+		}
+		for uuid, err := range n.BulkInsert(m) {
+			res.Errors = append(res.Errors, toError(uuid, err))
+		}
+	}
+	return res, nil
+}
+
+func toError(uuid string, err error) *payload.Common_Error {
+	return &payload.Common_Error{
+		Id:        uuid,
+		Msg:       err.Error(),
+		Timestamp: time.Now().UnixNano(),
 	}
-	return
 }
 
 func (s *server) Update(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
-	err := s.ngt.Update(vec.GetId().GetId(), vec.GetVector())
+	err := validVector(vec)
+	if err != nil {
+		return toError(vec.GetId().GetId(), err), err
+	}
+	n, err := s.index(vec.GetIndex())
+	if err == nil {
+		if fv := vec.GetFloat32Vector(); len(fv) != 0 {
+			err = n.UpdateFloat32(vec.GetId().GetId(), fv)
+		} else {
+			err = n.Update(vec.GetId().GetId(), vec.GetVector())
+		}
+	}
 	if err != nil {
 		return &payload.Common_Error{
 			Msg:       err.Error(),
@@ -176,7 +387,10 @@ func (s *server) MultiUpdate(ctx context.Context, vecs *payload.Object_Vectors)
 }
 
 func (s *server) Remove(ctx context.Context, id *payload.Object_ID) (*payload.Common_Error, error) {
-	err := s.ngt.Delete(id.GetId())
+	n, err := s.index(id.GetIndex())
+	if err == nil {
+		err = n.Delete(id.GetId())
+	}
 	if err != nil {
 		return &payload.Common_Error{
 			Msg:       err.Error(),
@@ -205,7 +419,11 @@ func (s *server) MultiRemove(ctx context.Context, ids *payload.Object_IDs) (res
 }
 
 func (s *server) GetObject(ctx context.Context, id *payload.Object_ID) (*payload.Object_Vector, error) {
-	vec, err := s.ngt.GetObject(id.GetId())
+	n, err := s.index(id.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	vec, err := n.GetObject(id.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +432,7 @@ func (s *server) GetObject(ctx context.Context, id *payload.Object_ID) (*payload
 			Id: id.GetId(),
 		},
 		Vector: vec,
+		Index:  id.GetIndex(),
 	}, nil
 
 }
@@ -224,10 +443,191 @@ func (s *server) StreamGetObject(stream agent.Agent_StreamGetObjectServer) error
 	})
 }
 
+// StreamListObject sends the ids of all the objects stored in the index.
+func (s *server) StreamListObject(req *payload.Controll_IndexRequest, stream agent.Agent_StreamListObjectServer) error {
+	n, err := s.index(req.GetIndex())
+	if err != nil {
+		return err
+	}
+	for _, uuid := range n.UUIDs(stream.Context()) {
+		err = stream.Send(&payload.Object_ID{
+			Id:    uuid,
+			Index: req.GetIndex(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *server) CreateIndex(ctx context.Context, c *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error) {
-	return nil, s.ngt.CreateIndex(c.GetPoolSize())
+	n, err := s.index(c.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	return nil, n.CreateIndex(c.GetPoolSize())
+}
+
+func (s *server) SaveIndex(ctx context.Context, req *payload.Controll_IndexRequest) (*payload.Common_Empty, error) {
+	n, err := s.index(req.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	return nil, n.SaveIndex()
+}
+
+func (s *server) Compact(ctx context.Context, c *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error) {
+	n, err := s.index(c.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	return nil, n.Compact(c.GetPoolSize())
+}
+
+func (s *server) Snapshot(req *payload.Controll_IndexRequest, stream agent.Agent_SnapshotServer) error {
+	n, err := s.index(req.GetIndex())
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(&chunkWriter{stream.Send}, snapshotChunkSize)
+	err = n.Snapshot(w)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// Restore restores the index named by the first chunk.
+func (s *server) Restore(stream agent.Agent_RestoreServer) error {
+	c, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	n, err := s.index(c.GetIndex())
+	if err != nil {
+		return err
+	}
+	err = n.Restore(&chunkReader{
+		recv: stream.Recv,
+		buf:  c.GetData(),
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(new(payload.Common_Empty))
+}
+
+// Import imports the dataset file on the agent and sends the progress after each chunk.
+func (s *server) Import(req *payload.Controll_ImportRequest, stream agent.Agent_ImportServer) error {
+	n, err := s.index(req.GetIndex())
+	if err != nil {
+		return err
+	}
+
+	path, err := s.importPath(req.GetPath())
+	if err != nil {
+		return err
+	}
+
+	r, err := dataset.Open(path,
+		dataset.WithFormat(req.GetFormat()),
+		dataset.WithIDColumn(int(req.GetIdColumn())),
+		dataset.WithIDPrefix(req.GetIdPrefix()),
+		dataset.WithCSVHeader(req.GetHeader()),
+		dataset.WithHDF5Dataset(req.GetHdf5Dataset()),
+	)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return n.Import(stream.Context(), r, req.GetPoolSize(), func(inserted, failed uint64) error {
+		return stream.Send(&payload.Controll_ImportProgress{
+			Inserted: inserted,
+			Failed:   failed,
+		})
+	})
 }
 
-func (s *server) SaveIndex(context.Context, *payload.Common_Empty) (*payload.Common_Empty, error) {
-	return nil, s.ngt.SaveIndex()
+// importPath resolves the dataset path of the request relative to the import directory,
+// and rejects the path escaping it including through the symbolic links.
+func (s *server) importPath(path string) (string, error) {
+	if s.importDir == "" {
+		return "", errors.ErrImportDirNotConfigured
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Clean(s.importDir))
+	if err != nil {
+		return "", err
+	}
+	p := filepath.Clean(path)
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(dir, p)
+	}
+	p, err = filepath.EvalSymlinks(p)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(dir, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.ErrImportPathOutsideDir(path, s.importDir)
+	}
+	return p, nil
+}
+
+func (s *server) IndexInfo(ctx context.Context, req *payload.Controll_IndexRequest) (*payload.Info_Index, error) {
+	n, err := s.index(req.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	info, err := n.IndexInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &payload.Info_Index{
+		Stored:           info.Stored,
+		Indexed:          info.Indexed,
+		Uncommitted:      info.Uncommitted,
+		Removed:          info.Removed,
+		Dimension:        uint32(info.Dimension),
+		ObjectType:       info.ObjectType,
+		DistanceType:     info.DistanceType,
+		CreationEdgeSize: uint32(info.CreationEdgeSize),
+		SearchEdgeSize:   uint32(info.SearchEdgeSize),
+		DiskSize:         info.DiskSize,
+	}, nil
+}
+
+// chunkWriter sends each write as a snapshot chunk.
+type chunkWriter struct {
+	send func(*payload.Snapshot_Chunk) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	err := w.send(&payload.Snapshot_Chunk{
+		Data: p,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// chunkReader reads the data of the received snapshot chunks as a stream.
+type chunkReader struct {
+	recv func() (*payload.Snapshot_Chunk, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		c, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = c.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
 }
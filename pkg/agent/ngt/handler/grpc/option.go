@@ -31,8 +31,23 @@ var (
 	defaultOpts = []Option{}
 )
 
+// WithNGT sets the default index, which serves the requests without an index name.
 func WithNGT(n service.NGT) Option {
+	return WithNamedNGT("", n)
+}
+
+// WithNamedNGT sets the index which serves the requests with the given index name.
+func WithNamedNGT(name string, n service.NGT) Option {
+	return func(s *server) {
+		if n != nil {
+			s.ngts[name] = n
+		}
+	}
+}
+
+// WithImportDir sets the directory Import reads the datasets from, Import is rejected unless it is set.
+func WithImportDir(dir string) Option {
 	return func(s *server) {
-		s.ngt = n
+		s.importDir = dir
 	}
 }
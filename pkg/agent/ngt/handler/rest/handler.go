@@ -39,6 +39,7 @@ type Handler interface {
 	Exists(w http.ResponseWriter, r *http.Request) error
 	Search(w http.ResponseWriter, r *http.Request) error
 	SearchByID(w http.ResponseWriter, r *http.Request) error
+	MultiSearch(w http.ResponseWriter, r *http.Request) error
 	Insert(w http.ResponseWriter, r *http.Request) error
 	MultiInsert(w http.ResponseWriter, r *http.Request) error
 	Update(w http.ResponseWriter, r *http.Request) error
@@ -47,6 +48,8 @@ type Handler interface {
 	MultiRemove(w http.ResponseWriter, r *http.Request) error
 	CreateIndex(w http.ResponseWriter, r *http.Request) error
 	SaveIndex(w http.ResponseWriter, r *http.Request) error
+	IndexInfo(w http.ResponseWriter, r *http.Request) error
+	Compact(w http.ResponseWriter, r *http.Request) error
 	GetObject(w http.ResponseWriter, r *http.Request) error
 }
 
@@ -87,6 +90,25 @@ func (h *handler) Search(w http.ResponseWriter, r *http.Request) (err error) {
 	return nil
 }
 
+func (h *handler) MultiSearch(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Search_MultiRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	res, err := h.agent.MultiSearch(r.Context(), req)
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (h *handler) SearchByID(w http.ResponseWriter, r *http.Request) (err error) {
 	var req *payload.Search_IDRequest
 	err = json.NewDecoder(r.Body).Decode(&req)
@@ -242,10 +264,47 @@ func (h *handler) CreateIndex(w http.ResponseWriter, r *http.Request) (err error
 func (h *handler) SaveIndex(w http.ResponseWriter, r *http.Request) (err error) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	_, err = h.agent.SaveIndex(r.Context(), nil)
+	_, err = h.agent.SaveIndex(r.Context(), &payload.Controll_IndexRequest{
+		Index: r.URL.Query().Get("index"),
+	})
 	return
 }
 
+func (h *handler) Compact(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Controll_CreateIndexRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	res, err := h.agent.Compact(r.Context(), req)
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *handler) IndexInfo(w http.ResponseWriter, r *http.Request) (err error) {
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	res, err := h.agent.IndexInfo(r.Context(), &payload.Controll_IndexRequest{
+		Index: r.URL.Query().Get("index"),
+	})
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (h *handler) GetObject(w http.ResponseWriter, r *http.Request) (err error) {
 	var req *payload.Object_ID
 	err = json.NewDecoder(r.Body).Decode(&req)
@@ -27,3 +27,23 @@ type Distance struct {
 	ID       string
 	Distance float32
 }
+
+type IndexInfo struct {
+	Stored           uint64
+	Indexed          uint64
+	Uncommitted      uint64
+	Removed          uint64
+	Dimension        int
+	ObjectType       string
+	DistanceType     string
+	CreationEdgeSize int
+	SearchEdgeSize   int
+	DiskSize         int64
+}
+
+// Filter restricts search results by uuid.
+// Only the uuids in Includes are returned when it is not empty, and the uuids in Excludes are never returned.
+type Filter struct {
+	Includes map[string]struct{}
+	Excludes map[string]struct{}
+}
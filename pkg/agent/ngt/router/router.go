@@ -64,6 +64,14 @@ func New(opts ...Option) http.Handler {
 				"/search",
 				h.Search,
 			},
+			{
+				"Multiple Search",
+				[]string{
+					http.MethodPost,
+				},
+				"/search/multi",
+				h.MultiSearch,
+			},
 			{
 				"Search By ID",
 				[]string{
@@ -141,6 +149,22 @@ func New(opts ...Option) http.Handler {
 				"/index/save",
 				h.SaveIndex,
 			},
+			{
+				"Compact Index",
+				[]string{
+					http.MethodGet,
+				},
+				"/index/compact/{pool}",
+				h.Compact,
+			},
+			{
+				"Index Info",
+				[]string{
+					http.MethodGet,
+				},
+				"/index/info",
+				h.IndexInfo,
+			},
 			{
 				"GetObject",
 				[]string{
@@ -0,0 +1,144 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Indexer creates and saves the NGT index in background.
+type Indexer interface {
+	Start(ctx context.Context) <-chan error
+	Stop()
+}
+
+type indexer struct {
+	ngt                 NGT
+	poolSize            uint32
+	createIndexLength   uint64
+	createIndexDuration time.Duration
+	checkDuration       time.Duration
+	saveIndexDuration   time.Duration
+	compactRatio        float64
+	cancel              context.CancelFunc
+	wg                  sync.WaitGroup
+}
+
+func NewIndexer(opts ...IndexerOption) Indexer {
+	i := new(indexer)
+	for _, opt := range append(defaultIndexerOpts, opts...) {
+		opt(i)
+	}
+	return i
+}
+
+// Start runs the indexing daemon.
+// CreateIndex is called when the uncommitted insert count reaches the limit or
+// the create index duration elapses with uncommitted inserts,
+// SaveIndex is called every save index duration,
+// and Compact is called when the ratio of the removed objects reaches the compact ratio.
+func (i *indexer) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 2)
+
+	ctx, i.cancel = context.WithCancel(ctx)
+
+	i.wg.Add(1)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer i.wg.Done()
+		defer close(ech)
+
+		var (
+			check <-chan time.Time
+			save  <-chan time.Time
+			last  = time.Now()
+		)
+
+		if i.ngt == nil || (i.createIndexLength == 0 && i.createIndexDuration <= 0 && i.saveIndexDuration <= 0 && i.compactRatio <= 0) {
+			return nil
+		}
+
+		if (i.createIndexLength > 0 || i.createIndexDuration > 0 || i.compactRatio > 0) && i.checkDuration > 0 {
+			ct := time.NewTicker(i.checkDuration)
+			defer ct.Stop()
+			check = ct.C
+		}
+
+		if i.saveIndexDuration > 0 {
+			st := time.NewTicker(i.saveIndexDuration)
+			defer st.Stop()
+			save = st.C
+		}
+
+		log.Info("auto indexer started")
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("auto indexer stopped")
+				return nil
+			case <-check:
+				ic := i.ngt.UncommittedCount()
+				if ic > 0 && ((i.createIndexLength > 0 && ic >= i.createIndexLength) ||
+					(i.createIndexDuration > 0 && time.Since(last) >= i.createIndexDuration)) {
+					log.Infof("auto indexer creating index for %d uncommitted objects", ic)
+					err = i.ngt.CreateIndex(i.poolSize)
+					last = time.Now()
+				}
+				if err == nil && i.compactRatio > 0 {
+					if r := i.ngt.RemovedRatio(); r >= i.compactRatio {
+						log.Infof("auto indexer compacting index for removed ratio %f", r)
+						err = i.ngt.Compact(i.poolSize)
+						last = time.Now()
+					}
+				}
+			case <-save:
+				log.Info("auto indexer saving index")
+				err = i.ngt.SaveIndex()
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+				err = nil
+			}
+		}
+	}))
+
+	return ech
+}
+
+// Stop stops the indexing daemon and waits for the running CreateIndex or SaveIndex.
+func (i *indexer) Stop() {
+	if i.cancel != nil {
+		i.cancel()
+	}
+	i.wg.Wait()
+}
@@ -24,37 +24,89 @@
 package service
 
 import (
+	"context"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
+	"sync"
+	"sync/atomic"
 
 	"github.com/kpango/gache"
+	"github.com/vdaas/vald/internal/archive"
 	"github.com/vdaas/vald/internal/config"
 	core "github.com/vdaas/vald/internal/core/ngt"
+	"github.com/vdaas/vald/internal/dataset"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/wal"
 	"github.com/vdaas/vald/pkg/agent/ngt/model"
 )
 
 type NGT interface {
-	Search(vec []float64, size uint32, epsilon, radius float32) ([]model.Distance, error)
-	SearchByID(uuid string, size uint32, epsilon, radius float32) ([]model.Distance, error)
+	Search(vec []float64, size uint32, epsilon, radius float32, filter *model.Filter) ([]model.Distance, error)
+	SearchFloat32(vec []float32, size uint32, epsilon, radius float32, filter *model.Filter) ([]model.Distance, error)
+	SearchByID(uuid string, size uint32, epsilon, radius float32, filter *model.Filter) ([]model.Distance, error)
+	ExactSearch(vec []float64, size uint32, filter *model.Filter) ([]model.Distance, error)
+	BulkSearch(vecs [][]float64, size uint32, epsilon, radius float32, filter *model.Filter) ([][]model.Distance, []error)
 	Insert(uuid string, vec []float64) (err error)
+	InsertFloat32(uuid string, vec []float32) (err error)
+	BulkInsert(vecs map[string][]float64) (errs map[string]error)
 	Update(uuid string, vec []float64) (err error)
+	UpdateFloat32(uuid string, vec []float32) (err error)
 	Delete(uuid string) (err error)
 	GetObject(uuid string) (vec []float64, err error)
 	CreateIndex(poolSize uint32) (err error)
 	SaveIndex() (err error)
 	Exists(string) (string, bool)
+	// UUIDs returns the uuids of the stored objects in no particular order.
+	UUIDs(ctx context.Context) []string
 	CreateAndSaveIndex(poolSize uint32) (err error)
+	Compact(poolSize uint32) (err error)
+	Snapshot(w io.Writer) (err error)
+	Restore(r io.Reader) (err error)
+	Import(ctx context.Context, r dataset.Reader, poolSize uint32, progress func(inserted, failed uint64) error) (err error)
+	UncommittedCount() uint64
+	RemovedRatio() float64
+	IndexInfo() (*model.IndexInfo, error)
 	Close()
 }
 
 type ngt struct {
-	ou   gache.Gache // map[oid]uuid
-	uo   gache.Gache // map[uuid]oid
-	core core.NGT
+	// mu serializes mutations so that the write-ahead log order matches the index,
+	// and keeps SaveIndex from observing a half applied mutation.
+	mu sync.Mutex
+	// rmu guards ou and uo against the replacement by Compact,
+	// readers translating object ids to uuids hold it while using the ids.
+	rmu     sync.RWMutex
+	idxPath string
+	kvsPath string
+	ou      gache.Gache // map[oid]uuid
+	uo      gache.Gache // map[uuid]oid
+	core    core.NGT
+	wal     wal.WAL
+	ic      uint64 // number of inserted objects not indexed yet
+
+	// overFetchRatio multiplies the search size of the filtered search
+	overFetchRatio int
 }
 
+const (
+	// kvsFileName is the name of the uuid <-> object id mapping file stored in the index directory
+	kvsFileName = "ngt-meta.kvsdb"
+
+	// walFileName is the name of the write-ahead log file stored in the index directory
+	walFileName = "ngt-wal.log"
+
+	// defaultOverFetchRatio is used when filter_over_fetch_ratio is not configured
+	defaultOverFetchRatio = 4
+
+	// importChunkSize is the number of vectors Import inserts, indexes and saves at once
+	importChunkSize = 10000
+)
+
 func NewNGT(cfg *config.NGT) (NGT, error) {
 
 	var (
@@ -67,8 +119,10 @@ func NewNGT(cfg *config.NGT) (NGT, error) {
 			core.WithDistanceTypeByString(cfg.DistanceType),
 			core.WithObjectTypeByString(cfg.ObjectType),
 			core.WithBulkInsertChunkSize(cfg.BulkInsertChunkSize),
+			core.WithBulkSearchPoolSize(cfg.BulkSearchPoolSize),
 			core.WithCreationEdgeSize(cfg.CreationEdgeSize),
 			core.WithSearchEdgeSize(cfg.SearchEdgeSize),
+			core.WithDoubleBuffer(cfg.EnableDoubleBuffer),
 		}
 
 		ou = gache.New().
@@ -80,25 +134,172 @@ func NewNGT(cfg *config.NGT) (NGT, error) {
 			DisableExpiredHook()
 	)
 
-	if _, err := os.Stat(cfg.IndexPath); os.IsNotExist(err) {
-		n, err = core.New(opts...)
-	} else {
+	_, err = os.Stat(cfg.IndexPath)
+	isLoad := !os.IsNotExist(err)
+	if isLoad {
 		n, err = core.Load(opts...)
+	} else {
+		n, err = core.New(opts...)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &ngt{
-		ou:   ou,
-		uo:   uo,
-		core: n,
-	}, nil
+	nn := &ngt{
+		idxPath: cfg.IndexPath,
+		kvsPath: filepath.Join(cfg.IndexPath, kvsFileName),
+		ou:      ou,
+		uo:      uo,
+		core:    n,
+
+		overFetchRatio: cfg.FilterOverFetchRatio,
+	}
+	if nn.overFetchRatio <= 0 {
+		nn.overFetchRatio = defaultOverFetchRatio
+	}
+
+	if isLoad {
+		err = nn.loadKVS()
+		if err != nil {
+			nn.Close()
+			return nil, err
+		}
+	}
+
+	nn.wal, err = wal.New(
+		wal.WithPath(filepath.Join(cfg.IndexPath, walFileName)),
+		wal.WithSyncPolicy(cfg.WALSyncPolicy),
+		wal.WithSyncInterval(cfg.WALSyncInterval),
+	)
+	if err != nil {
+		nn.Close()
+		return nil, err
+	}
+
+	err = nn.replay()
+	if err != nil {
+		nn.Close()
+		return nil, err
+	}
+
+	return nn, nil
+}
+
+func (n *ngt) Search(vec []float64, size uint32, epsilon, radius float32, filter *model.Filter) ([]model.Distance, error) {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+	return n.filteredSearch(int(size), filter, func(size int) ([]core.SearchResult, error) {
+		return n.core.Search(vec, size, epsilon, radius)
+	})
+}
+
+func (n *ngt) SearchFloat32(vec []float32, size uint32, epsilon, radius float32, filter *model.Filter) ([]model.Distance, error) {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+	return n.filteredSearch(int(size), filter, func(size int) ([]core.SearchResult, error) {
+		return n.core.SearchFloat32(vec, size, epsilon, radius)
+	})
+}
+
+// ExactSearch returns the exact nearest neighbors by scanning all objects, it is slow and meant for recall measurement.
+func (n *ngt) ExactSearch(vec []float64, size uint32, filter *model.Filter) ([]model.Distance, error) {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+	return n.filteredSearch(int(size), filter, func(size int) ([]core.SearchResult, error) {
+		return n.core.ExactSearch(vec, size)
+	})
 }
 
-func (n *ngt) Search(vec []float64, size uint32, epsilon, radius float32) ([]model.Distance, error) {
-	sr, err := n.core.Search(vec, int(size), epsilon, radius)
+func (n *ngt) BulkSearch(vecs [][]float64, size uint32, epsilon, radius float32, filter *model.Filter) ([][]model.Distance, []error) {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+
+	if filter == nil {
+		srs, errs := n.core.BulkSearch(vecs, int(size), epsilon, radius)
+		dss := make([][]model.Distance, len(srs))
+		for i, sr := range srs {
+			dss[i], errs[i] = n.toDistances(sr, errs[i])
+		}
+		return dss, errs
+	}
+
+	fetch := int(size) * n.overFetchRatio
+	srs, errs := n.core.BulkSearch(vecs, fetch, epsilon, radius)
+	dss := make([][]model.Distance, len(srs))
+	for i, sr := range srs {
+		dss[i], errs[i] = n.toDistances(sr, errs[i])
+		if errs[i] != nil || len(sr) < fetch {
+			dss[i] = filterDistances(dss[i], int(size), filter)
+			continue
+		}
+		if ds := filterDistances(dss[i], int(size), filter); len(ds) >= int(size) {
+			dss[i] = ds
+			continue
+		}
+		// too few results passed the filter, falls back to the widening search for this query
+		vec := vecs[i]
+		dss[i], errs[i] = n.filteredSearch(int(size), filter, func(size int) ([]core.SearchResult, error) {
+			return n.core.Search(vec, size, epsilon, radius)
+		})
+	}
+	return dss, errs
+}
+
+// filteredSearch calls search with the over-fetched size and drops the results rejected by the filter.
+// The size is doubled until the filter passes enough results or the whole index is fetched.
+func (n *ngt) filteredSearch(size int, filter *model.Filter, search func(size int) ([]core.SearchResult, error)) ([]model.Distance, error) {
+	if filter == nil {
+		return n.toDistances(search(size))
+	}
+
+	limit := int(n.core.ObjectCount())
+	if limit < size {
+		limit = size
+	}
+	fetch := size * n.overFetchRatio
+	if len(filter.Includes) != 0 && fetch < len(filter.Includes) {
+		fetch = len(filter.Includes)
+	}
+
+	for {
+		if fetch > limit {
+			fetch = limit
+		}
+		sr, err := search(fetch)
+		ds, err := n.toDistances(sr, err)
+		if err != nil {
+			return nil, err
+		}
+		ds = filterDistances(ds, size, filter)
+		if len(ds) >= size || len(sr) < fetch || fetch >= limit {
+			return ds, nil
+		}
+		fetch *= 2
+	}
+}
+
+// filterDistances returns at most size distances passing the filter.
+func filterDistances(ds []model.Distance, size int, filter *model.Filter) []model.Distance {
+	res := ds[:0]
+	for _, d := range ds {
+		if len(res) >= size {
+			break
+		}
+		if _, ok := filter.Excludes[d.ID]; ok {
+			continue
+		}
+		if len(filter.Includes) != 0 {
+			if _, ok := filter.Includes[d.ID]; !ok {
+				continue
+			}
+		}
+		res = append(res, d)
+	}
+	return res
+}
+
+func (n *ngt) toDistances(sr []core.SearchResult, err error) ([]model.Distance, error) {
 	if err != nil {
 		return nil, err
 	}
@@ -108,79 +309,240 @@ func (n *ngt) Search(vec []float64, size uint32, epsilon, radius float32) ([]mod
 		errs error
 	)
 
-	for i, d := range sr {
+	for _, d := range sr {
 		if err = d.Error; d.ID == 0 && err != nil {
 			errs = errors.Wrap(errs, err.Error())
 			continue
 		}
 		key, ok := n.ou.Get(strconv.FormatInt(int64(d.ID), 10))
 		if ok {
-			ds[i] = model.Distance{
+			ds = append(ds, model.Distance{
 				ID:       key.(string),
 				Distance: d.Distance,
-			}
+			})
 		} else {
 			log.Warn(errors.ErrUUIDNotFound(d.ID))
 		}
 	}
 
-	return ds[:len(ds)], errs
+	return ds, errs
 }
 
-func (n *ngt) SearchByID(uuid string, size uint32, epsilon, radius float32) ([]model.Distance, error) {
+func (n *ngt) SearchByID(uuid string, size uint32, epsilon, radius float32, filter *model.Filter) ([]model.Distance, error) {
+	n.rmu.RLock()
 	oid, ok := n.uo.Get(uuid)
 	if !ok {
+		n.rmu.RUnlock()
 		return nil, errors.ErrObjectIDNotFound(uuid)
 	}
 	vec, err := n.core.GetVector(oid.(uint))
+	n.rmu.RUnlock()
 	if err != nil {
 		return nil, errors.ErrObjectNotFound(err, uuid)
 	}
 
-	return n.Search(vec, size, epsilon, radius)
+	return n.Search(vec, size, epsilon, radius, filter)
 }
 
 func (n *ngt) Insert(uuid string, vec []float64) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.insert(uuid, vec, true)
+}
+
+func (n *ngt) insert(uuid string, vec []float64, logging bool) (err error) {
 	i, ok := n.uo.Get(uuid)
-	if ok && i != 0 {
-		err = errors.ErrUUIDAlreadyExists(uuid, i.(uint32))
+	if ok && i.(uint) != 0 {
+		err = errors.ErrUUIDAlreadyExists(uuid, uint32(i.(uint)))
 		return err
 	}
 
+	if logging {
+		err = n.wal.Append(&wal.Entry{
+			Op:     wal.OpInsert,
+			UUID:   uuid,
+			Vector: vec,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	oid, err := n.core.Insert(vec)
 	if err != nil {
 		return err
 	}
+	n.register(uuid, oid)
 
-	n.uo.SetWithExpire(uuid, oid, 0)
-	n.ou.SetWithExpire(strconv.FormatInt(int64(oid), 10), uuid, 0)
+	return nil
+}
+
+func (n *ngt) InsertFloat32(uuid string, vec []float32) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.insertFloat32(uuid, vec, true)
+}
+
+func (n *ngt) insertFloat32(uuid string, vec []float32, logging bool) (err error) {
+	i, ok := n.uo.Get(uuid)
+	if ok && i.(uint) != 0 {
+		err = errors.ErrUUIDAlreadyExists(uuid, uint32(i.(uint)))
+		return err
+	}
+
+	if logging {
+		err = n.wal.Append(&wal.Entry{
+			Op:     wal.OpInsert,
+			UUID:   uuid,
+			Vector: float32ToFloat64(vec),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	oid, err := n.core.InsertFloat32(vec)
+	if err != nil {
+		return err
+	}
+	n.register(uuid, oid)
 
 	return nil
 }
 
+// BulkInsert inserts the vectors under a single lock and returns the errors of the failed uuids.
+func (n *ngt) BulkInsert(vecs map[string][]float64) (errs map[string]error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	errs = make(map[string]error)
+	uuids := make([]string, 0, len(vecs))
+	vs := make([][]float64, 0, len(vecs))
+	for uuid, vec := range vecs {
+		i, ok := n.uo.Get(uuid)
+		if ok && i.(uint) != 0 {
+			errs[uuid] = errors.ErrUUIDAlreadyExists(uuid, uint32(i.(uint)))
+			continue
+		}
+		err := n.wal.Append(&wal.Entry{
+			Op:     wal.OpInsert,
+			UUID:   uuid,
+			Vector: vec,
+		})
+		if err != nil {
+			errs[uuid] = err
+			continue
+		}
+		uuids = append(uuids, uuid)
+		vs = append(vs, vec)
+	}
+	if len(vs) == 0 {
+		return errs
+	}
+
+	// the core leaves 0 for each failed vector and reports its error in the same order
+	oids, ierrs := n.core.BulkInsert(vs)
+	for i, oid := range oids {
+		if oid != 0 {
+			n.register(uuids[i], oid)
+			continue
+		}
+		if len(ierrs) != 0 {
+			errs[uuids[i]], ierrs = ierrs[0], ierrs[1:]
+		}
+	}
+
+	return errs
+}
+
+func (n *ngt) register(uuid string, oid uint) {
+	atomic.AddUint64(&n.ic, 1)
+
+	n.uo.SetWithExpire(uuid, oid, 0)
+	n.ou.SetWithExpire(strconv.FormatInt(int64(oid), 10), uuid, 0)
+}
+
 func (n *ngt) Update(uuid string, vec []float64) (err error) {
-	err = n.Delete(uuid)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.update(uuid, vec, true)
+}
+
+func (n *ngt) update(uuid string, vec []float64, logging bool) (err error) {
+	if logging {
+		if _, ok := n.uo.Get(uuid); !ok {
+			return errors.ErrObjectIDNotFound(uuid)
+		}
+		err = n.wal.Append(&wal.Entry{
+			Op:     wal.OpUpdate,
+			UUID:   uuid,
+			Vector: vec,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = n.delete(uuid, false)
 	if err != nil {
 		return err
 	}
-	oid, err := n.core.Insert(vec)
+
+	return n.insert(uuid, vec, false)
+}
+
+func (n *ngt) UpdateFloat32(uuid string, vec []float32) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, ok := n.uo.Get(uuid); !ok {
+		return errors.ErrObjectIDNotFound(uuid)
+	}
+	err = n.wal.Append(&wal.Entry{
+		Op:     wal.OpUpdate,
+		UUID:   uuid,
+		Vector: float32ToFloat64(vec),
+	})
 	if err != nil {
 		return err
 	}
 
-	n.uo.SetWithExpire(uuid, oid, 0)
-	n.ou.SetWithExpire(strconv.FormatInt(int64(oid), 10), uuid, 0)
+	err = n.delete(uuid, false)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return n.insertFloat32(uuid, vec, false)
 }
 
 func (n *ngt) Delete(uuid string) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.delete(uuid, true)
+}
+
+func (n *ngt) delete(uuid string, logging bool) (err error) {
 	i, ok := n.uo.Get(uuid)
-	oid := i.(uint)
-	if !ok || oid == 0 {
+	if !ok || i.(uint) == 0 {
 		err = errors.ErrObjectIDNotFound(uuid)
 		return err
 	}
+	oid := i.(uint)
+
+	if logging {
+		err = n.wal.Append(&wal.Entry{
+			Op:   wal.OpDelete,
+			UUID: uuid,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	err = n.core.Remove(oid)
 	if err != nil {
 		return err
@@ -192,7 +554,30 @@ func (n *ngt) Delete(uuid string) (err error) {
 	return nil
 }
 
+// replay re-applies the mutations logged after the last SaveIndex.
+func (n *ngt) replay() error {
+	return n.wal.Replay(func(e *wal.Entry) (err error) {
+		switch e.Op {
+		case wal.OpInsert:
+			err = n.insert(e.UUID, e.Vector, false)
+		case wal.OpUpdate:
+			err = n.update(e.UUID, e.Vector, false)
+		case wal.OpDelete:
+			err = n.delete(e.UUID, false)
+		default:
+			err = errors.ErrWALInvalidEntry
+		}
+		if err != nil {
+			log.Warn(errors.ErrWALReplayFailed(err, e.UUID))
+		}
+		return nil
+	})
+}
+
 func (n *ngt) GetObject(uuid string) (vec []float64, err error) {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+
 	i, ok := n.uo.Get(uuid)
 
 	if !ok || i == 0 {
@@ -204,18 +589,438 @@ func (n *ngt) GetObject(uuid string) (vec []float64, err error) {
 }
 
 func (n *ngt) CreateIndex(poolSize uint32) (err error) {
-	return n.core.CreateIndex(poolSize)
+	ic := atomic.LoadUint64(&n.ic)
+	err = n.core.CreateIndex(poolSize)
+	if err != nil {
+		return err
+	}
+	// inserts done while indexing remain uncommitted
+	atomic.AddUint64(&n.ic, ^(ic - 1))
+	return nil
+}
+
+func (n *ngt) UncommittedCount() uint64 {
+	return atomic.LoadUint64(&n.ic)
 }
 
+// IndexInfo returns the object counts, the property and the on-disk size of the index.
+func (n *ngt) IndexInfo() (*model.IndexInfo, error) {
+	prop, err := n.core.Property()
+	if err != nil {
+		return nil, err
+	}
+
+	var size int64
+	err = filepath.Walk(n.idxPath, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.IndexInfo{
+		Stored:           n.core.ObjectCount(),
+		Indexed:          n.core.IndexedCount(),
+		Uncommitted:      n.UncommittedCount(),
+		Removed:          n.core.RemovedCount(),
+		Dimension:        prop.Dimension,
+		ObjectType:       prop.ObjectType,
+		DistanceType:     prop.DistanceType,
+		CreationEdgeSize: prop.CreationEdgeSize,
+		SearchEdgeSize:   prop.SearchEdgeSize,
+		DiskSize:         size,
+	}, nil
+}
+
+// SaveIndex stores the NGT index and the uuid <-> object id mapping together.
+// The mapping is written to a temporary file first and renamed after the index is saved,
+// so a reader never observes a mapping newer than the index.
 func (n *ngt) SaveIndex() (err error) {
-	return n.core.SaveIndex()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.saveIndex()
+}
+
+func (n *ngt) saveIndex() (err error) {
+	tmp, err := n.writeKVS()
+	if err != nil {
+		return err
+	}
+
+	err = n.core.SaveIndex()
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	err = os.Rename(tmp, n.kvsPath)
+	if err != nil {
+		return err
+	}
+
+	return n.wal.Truncate()
+}
+
+// Compact rebuilds the index from the live objects to drop the removed objects left in the graph,
+// replaces the uuid <-> object id mapping with the new object ids and saves both.
+// Search keeps being served during the rebuild, while mutations wait until it finishes.
+func (n *ngt) Compact(poolSize uint32) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err = n.core.Rebuild(poolSize, func(ids map[uint]uint, swap func()) (err error) {
+		var (
+			mu sync.Mutex
+			ou = gache.New().
+				SetDefaultExpire(0).
+				DisableExpiredHook()
+			uo = gache.New().
+				SetDefaultExpire(0).
+				DisableExpiredHook()
+		)
+		n.uo.Foreach(context.Background(), func(uuid string, oid interface{}, _ int64) bool {
+			nid, ok := ids[oid.(uint)]
+			if !ok {
+				mu.Lock()
+				err = errors.ErrObjectNotFound(err, uuid)
+				mu.Unlock()
+				return false
+			}
+			uo.SetWithExpire(uuid, nid, 0)
+			ou.SetWithExpire(strconv.FormatInt(int64(nid), 10), uuid, 0)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		n.rmu.Lock()
+		swap()
+		n.ou, n.uo = ou, uo
+		n.rmu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the rebuilt index contains every inserted object
+	atomic.StoreUint64(&n.ic, 0)
+
+	return n.saveIndex()
+}
+
+// Snapshot writes a tar archive of the index and the uuid <-> object id mapping at a point in time to w.
+// Mutations wait only while both are copied to a temporary directory, and Search is not blocked.
+func (n *ngt) Snapshot(w io.Writer) (err error) {
+	dir, err := ioutil.TempDir(filepath.Dir(n.idxPath), "ngt-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	n.mu.Lock()
+	tmp, err := n.writeKVS()
+	if err == nil {
+		err = n.core.SaveIndexTo(dir)
+		if err != nil {
+			os.Remove(tmp)
+		}
+	}
+	n.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmp, filepath.Join(dir, kvsFileName))
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return archive.Tar(w, dir)
+}
+
+// Restore replaces the index and the uuid <-> object id mapping with the tar archive written by Snapshot.
+// Search keeps being served by the current index until the restored one is swapped in,
+// and the restored state is saved to the index path.
+func (n *ngt) Restore(r io.Reader) (err error) {
+	dir, err := ioutil.TempDir(filepath.Dir(n.idxPath), "ngt-restore-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	err = archive.Untar(r, dir)
+	if err != nil {
+		return err
+	}
+
+	m, err := readKVS(filepath.Join(dir, kvsFileName))
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err = n.core.Replace(dir, func(objects uint64, exists func(oid uint) error, swap func()) error {
+		var (
+			ou = gache.New().
+				SetDefaultExpire(0).
+				DisableExpiredHook()
+			uo = gache.New().
+				SetDefaultExpire(0).
+				DisableExpiredHook()
+		)
+		// the restored index is discarded unless the restored mapping matches it
+		err := setKVS(m, ou, uo, objects, exists)
+		if err != nil {
+			return err
+		}
+
+		n.rmu.Lock()
+		swap()
+		n.ou, n.uo = ou, uo
+		n.rmu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreUint64(&n.ic, n.core.ObjectCount()-n.core.IndexedCount())
+
+	return n.saveIndex()
+}
+
+// Import bulk-inserts the vectors of the dataset in chunks, indexing and saving the index with the mapping after each chunk
+// and calling progress.
+// The vectors whose uuid already exists or that NGT rejects are counted as failed,
+// while a broken dataset, a canceled ctx or an error of progress stops the import keeping the chunks saved so far.
+// Mutations wait only while a chunk is being applied, and the imported objects are not written to the write-ahead log but saved per chunk.
+func (n *ngt) Import(ctx context.Context, r dataset.Reader, poolSize uint32, progress func(inserted, failed uint64) error) (err error) {
+	var (
+		inserted, failed uint64
+		rerr             error
+		uuids            = make([]string, 0, importChunkSize)
+		vecs             = make([][]float64, 0, importChunkSize)
+		chunk            = make(map[string]struct{}, importChunkSize)
+	)
+	for rerr == nil {
+		err = ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		uuids, vecs = uuids[:0], vecs[:0]
+		for k := range chunk {
+			delete(chunk, k)
+		}
+		for len(vecs) < importChunkSize {
+			uuid, vec, err := r.Read()
+			if err != nil {
+				rerr = err
+				break
+			}
+			if _, ok := chunk[uuid]; ok {
+				log.Warn(errors.ErrDuplicatedDatasetUUID(uuid))
+				failed++
+				continue
+			}
+			chunk[uuid] = struct{}{}
+			uuids = append(uuids, uuid)
+			vecs = append(vecs, vec)
+		}
+
+		ins, fails, err := n.importChunk(uuids, vecs, poolSize)
+		inserted += ins
+		failed += fails
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			err = progress(inserted, failed)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if rerr != io.EOF {
+		return rerr
+	}
+	return nil
+}
+
+// importChunk inserts, indexes and saves a chunk of Import holding the lock only for the chunk.
+func (n *ngt) importChunk(uuids []string, vecs [][]float64, poolSize uint32) (inserted, failed uint64, err error) {
+	if len(vecs) == 0 {
+		return 0, 0, nil
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var (
+		ids = make([]string, 0, len(uuids))
+		vs  = make([][]float64, 0, len(vecs))
+	)
+	for i, uuid := range uuids {
+		if oid, ok := n.uo.Get(uuid); ok {
+			log.Warn(errors.ErrUUIDAlreadyExists(uuid, uint32(oid.(uint))))
+			failed++
+			continue
+		}
+		ids = append(ids, uuid)
+		vs = append(vs, vecs[i])
+	}
+	if len(vs) == 0 {
+		return inserted, failed, nil
+	}
+
+	oids, errs := n.core.BulkInsert(vs)
+	for _, err := range errs {
+		log.Warn(err)
+	}
+	for i, oid := range oids {
+		if oid == 0 {
+			failed++
+			continue
+		}
+		n.register(ids[i], oid)
+		inserted++
+	}
+
+	err = n.CreateIndex(poolSize)
+	if err != nil {
+		return inserted, failed, err
+	}
+	return inserted, failed, n.saveIndex()
+}
+
+// RemovedRatio returns the ratio of the removed objects left in the graph to all objects.
+func (n *ngt) RemovedRatio() float64 {
+	removed := n.core.RemovedCount()
+	if removed == 0 {
+		return 0
+	}
+	return float64(removed) / float64(removed+n.core.ObjectCount())
 }
 
 func (n *ngt) CreateAndSaveIndex(poolSize uint32) (err error) {
-	return n.core.CreateAndSaveIndex(poolSize)
+	err = n.CreateIndex(poolSize)
+	if err != nil {
+		return err
+	}
+	return n.SaveIndex()
+}
+
+// writeKVS dumps the uuid -> object id mapping to a temporary file next to kvsPath and returns its path.
+func (n *ngt) writeKVS() (string, error) {
+	var (
+		mu sync.Mutex
+		m  = make(map[string]uint32, n.uo.Len())
+	)
+	n.uo.Foreach(context.Background(), func(uuid string, oid interface{}, _ int64) bool {
+		mu.Lock()
+		m[uuid] = uint32(oid.(uint))
+		mu.Unlock()
+		return true
+	})
+
+	f, err := ioutil.TempFile(filepath.Dir(n.kvsPath), kvsFileName)
+	if err != nil {
+		return "", err
+	}
+
+	err = gob.NewEncoder(f).Encode(m)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+// loadKVS restores the uuid <-> object id mapping saved by SaveIndex
+// and fails when it does not agree with the loaded index.
+func (n *ngt) loadKVS() error {
+	m, err := readKVS(n.kvsPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		// the objects of the index are never found without their uuids
+		if n.core.ObjectCount() != 0 {
+			return errors.ErrIndexKVSInconsistent(errors.ErrKVSNotFound(n.kvsPath))
+		}
+		log.Warn(errors.ErrKVSNotFound(n.kvsPath))
+		return nil
+	}
+
+	return setKVS(m, n.ou, n.uo, n.core.ObjectCount(), func(oid uint) error {
+		_, err := n.core.GetVector(oid)
+		return err
+	})
+}
+
+// setKVS sets the uuid <-> object id mapping after checking every object id is unique and exists in the index,
+// and the index has no object left without uuid, of which count excludes the removed objects.
+func setKVS(m map[string]uint32, ou, uo gache.Gache, objects uint64, exists func(oid uint) error) error {
+	if objects != uint64(len(m)) {
+		return errors.ErrIndexKVSInconsistent(errors.ErrObjectCountMismatch(objects, len(m)))
+	}
+	for uuid, oid := range m {
+		key := strconv.FormatInt(int64(oid), 10)
+		if dup, ok := ou.Get(key); ok {
+			return errors.ErrIndexKVSInconsistent(errors.ErrDuplicatedObjectID(oid, dup.(string), uuid))
+		}
+		if err := exists(uint(oid)); err != nil {
+			return errors.ErrIndexKVSInconsistent(errors.ErrObjectNotFound(err, uuid))
+		}
+		uo.SetWithExpire(uuid, uint(oid), 0)
+		ou.SetWithExpire(key, uuid, 0)
+	}
+
+	return nil
+}
+
+// readKVS reads the uuid -> object id mapping file written by writeKVS.
+func readKVS(path string) (map[string]uint32, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := make(map[string]uint32)
+	err = gob.NewDecoder(f).Decode(&m)
+	if err != nil {
+		return nil, errors.ErrKVSLoadFailed(err, path)
+	}
+	return m, nil
 }
 
 func (n *ngt) Close() {
+	if n.wal != nil {
+		if err := n.wal.Close(); err != nil {
+			log.Error(err)
+		}
+	}
 	n.core.Close()
 	n.ou.Stop()
 	n.ou.Clear()
@@ -224,10 +1029,39 @@ func (n *ngt) Close() {
 }
 
 func (n *ngt) Exists(uuid string) (string, bool) {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+
 	oid, ok := n.uo.Get(uuid)
 	if !ok {
 		return "", false
 	}
 
-	return oid.(string), true
+	return strconv.FormatUint(uint64(oid.(uint)), 10), true
+}
+
+func (n *ngt) UUIDs(ctx context.Context) []string {
+	n.rmu.RLock()
+	defer n.rmu.RUnlock()
+
+	var (
+		mu    sync.Mutex
+		uuids = make([]string, 0, n.uo.Len())
+	)
+	n.uo.Foreach(ctx, func(uuid string, _ interface{}, _ int64) bool {
+		mu.Lock()
+		uuids = append(uuids, uuid)
+		mu.Unlock()
+		return true
+	})
+	return uuids
+}
+
+// float32ToFloat64 widens the vector for the write-ahead log, the conversion is lossless.
+func float32ToFloat64(vec []float32) []float64 {
+	ret := make([]float64, len(vec))
+	for i, v := range vec {
+		ret[i] = float64(v)
+	}
+	return ret
 }
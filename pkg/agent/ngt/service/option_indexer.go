@@ -0,0 +1,111 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type IndexerOption func(*indexer)
+
+var (
+	defaultIndexerOpts = []IndexerOption{
+		WithIndexerCheckDuration("1s"),
+		WithIndexerPoolSize(10000),
+	}
+)
+
+func WithIndexerNGT(n NGT) IndexerOption {
+	return func(i *indexer) {
+		i.ngt = n
+	}
+}
+
+func WithIndexerPoolSize(size uint32) IndexerOption {
+	return func(i *indexer) {
+		if size == 0 {
+			return
+		}
+		i.poolSize = size
+	}
+}
+
+func WithIndexerCreateIndexLength(l int) IndexerOption {
+	return func(i *indexer) {
+		if l <= 0 {
+			return
+		}
+		i.createIndexLength = uint64(l)
+	}
+}
+
+func WithIndexerCreateIndexDuration(dur string) IndexerOption {
+	return func(i *indexer) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			d = time.Minute
+		}
+		i.createIndexDuration = d
+	}
+}
+
+func WithIndexerCheckDuration(dur string) IndexerOption {
+	return func(i *indexer) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			d = time.Second
+		}
+		i.checkDuration = d
+	}
+}
+
+func WithIndexerSaveIndexDuration(dur string) IndexerOption {
+	return func(i *indexer) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			d = time.Minute * 30
+		}
+		i.saveIndexDuration = d
+	}
+}
+
+func WithIndexerCompactRatio(r float64) IndexerOption {
+	return func(i *indexer) {
+		if r <= 0 || r > 1 {
+			return
+		}
+		i.compactRatio = r
+	}
+}
@@ -2,8 +2,13 @@ package usecase
 
 import (
 	"context"
+	"sync"
 
+	iconfig "github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/pkg/agent/ngt/config"
 	"github.com/vdaas/vald/pkg/agent/ngt/handler/grpc"
 	"github.com/vdaas/vald/pkg/agent/ngt/handler/rest"
@@ -14,16 +19,51 @@ import (
 type Runner runner.Runner
 
 type run struct {
-	cfg    *config.Data
-	server service.Server
+	cfg      *config.Data
+	server   service.Server
+	indexers []service.Indexer
 }
 
-func New(cfg *config.Data) (Runner, error) {
+func New(cfg *config.Data) (r Runner, err error) {
 	ngt, err := service.NewNGT(cfg.NGT)
 	if err != nil {
 		return nil, err
 	}
-	g := grpc.New(grpc.WithNGT(ngt))
+	ngts := []service.NGT{ngt}
+	defer func() {
+		if err != nil {
+			for _, n := range ngts {
+				n.Close()
+			}
+		}
+	}()
+
+	gopts := []grpc.Option{
+		grpc.WithNGT(ngt),
+		grpc.WithImportDir(cfg.ImportDir),
+	}
+	indexers := []service.Indexer{newIndexer(ngt, cfg.NGT)}
+
+	names := map[string]struct{}{"": {}}
+	for _, idx := range cfg.Indexes {
+		if idx == nil {
+			continue
+		}
+		if _, ok := names[idx.Name]; ok {
+			return nil, errors.ErrDuplicatedIndexName(idx.Name)
+		}
+		names[idx.Name] = struct{}{}
+
+		var n service.NGT
+		n, err = service.NewNGT(idx)
+		if err != nil {
+			return nil, err
+		}
+		ngts = append(ngts, n)
+		gopts = append(gopts, grpc.WithNamedNGT(idx.Name, n))
+		indexers = append(indexers, newIndexer(n, idx))
+	}
+	g := grpc.New(gopts...)
 
 	srv, err := service.NewServer(
 		service.WithConfig(cfg.Server),
@@ -45,20 +85,72 @@ func New(cfg *config.Data) (Runner, error) {
 	}
 
 	return &run{
-		cfg:    cfg,
-		server: srv,
+		cfg:      cfg,
+		server:   srv,
+		indexers: indexers,
 	}, nil
 }
 
+func newIndexer(ngt service.NGT, cfg *iconfig.NGT) service.Indexer {
+	return service.NewIndexer(
+		service.WithIndexerNGT(ngt),
+		service.WithIndexerCheckDuration(cfg.AutoIndexCheckDuration),
+		service.WithIndexerCreateIndexDuration(cfg.AutoIndexDurationLimit),
+		service.WithIndexerSaveIndexDuration(cfg.AutoSaveIndexDuration),
+		service.WithIndexerCreateIndexLength(cfg.AutoIndexLength),
+		service.WithIndexerPoolSize(uint32(cfg.AutoIndexPoolSize)),
+		service.WithIndexerCompactRatio(cfg.AutoCompactRemovedRatio),
+	)
+}
+
 func (r *run) PreStart() error {
 	return nil
 }
 
 func (r *run) Start(ctx context.Context) <-chan error {
-	return r.server.ListenAndServe(ctx)
+	ech := make(chan error)
+	echs := []<-chan error{r.server.ListenAndServe(ctx)}
+	for _, idx := range r.indexers {
+		echs = append(echs, idx.Start(ctx))
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range echs {
+		c := c
+		wg.Add(1)
+		errgroup.Go(safety.RecoverFunc(func() error {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case err, ok := <-c:
+					if !ok {
+						return nil
+					}
+					if err != nil {
+						select {
+						case <-ctx.Done():
+							return nil
+						case ech <- err:
+						}
+					}
+				}
+			}
+		}))
+	}
+	errgroup.Go(safety.RecoverFunc(func() error {
+		wg.Wait()
+		close(ech)
+		return nil
+	}))
+	return ech
 }
 
 func (r *run) PreStop() error {
+	for _, idx := range r.indexers {
+		idx.Stop()
+	}
 	return nil
 }
 
@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package setting stores all server application settings
+package config
+
+import (
+	"github.com/vdaas/vald/internal/config"
+)
+
+// Config represent a application setting data content (config.yaml).
+// In K8s environment, this configuration is stored in K8s ConfigMap.
+type Data struct {
+	// Version represent configuration file version.
+	Version string `json:"version" yaml:"version"`
+
+	// Server represent all server configurations
+	Server *config.Servers `json:"server_config" yaml:"server_config"`
+
+	// DNS represent the DNS records of the agents
+	DNS *config.DNSDiscoverer `json:"dns" yaml:"dns"`
+}
+
+func NewConfig(path string) (cfg *Data, err error) {
+	err = config.Read(path, &cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Server != nil {
+		cfg.Server = cfg.Server.Bind()
+	}
+	if cfg.DNS != nil {
+		cfg.DNS = cfg.DNS.Bind()
+	} else {
+		cfg.DNS = new(config.DNSDiscoverer).Bind()
+	}
+
+	return cfg, nil
+}
+
+// func FakeData() {
+// 	d := Data{
+// 		Version: "v0.0.1",
+// 		Server: &config.Servers{
+// 			Servers: []*config.Server{
+// 				{
+// 					Name:              "agent-rest",
+// 					Host:              "127.0.0.1",
+// 					Port:              8080,
+// 					Mode:              "REST",
+// 					ProbeWaitTime:     "3s",
+// 					ShutdownDuration:  "5s",
+// 					HandlerTimeout:    "5s",
+// 					IdleTimeout:       "2s",
+// 					ReadHeaderTimeout: "1s",
+// 					ReadTimeout:       "1s",
+// 					WriteTimeout:      "1s",
+// 				},
+// 				{
+// 					Name: "agent-grpc",
+// 					Host: "127.0.0.1",
+// 					Port: 8082,
+// 					Mode: "GRPC",
+// 				},
+// 			},
+// 			MetricsServers: []*config.Server{
+// 				{
+// 					Name:              "pprof",
+// 					Host:              "127.0.0.1",
+// 					Port:              6060,
+// 					Mode:              "REST",
+// 					ProbeWaitTime:     "3s",
+// 					ShutdownDuration:  "5s",
+// 					HandlerTimeout:    "5s",
+// 					IdleTimeout:       "2s",
+// 					ReadHeaderTimeout: "1s",
+// 					ReadTimeout:       "1s",
+// 					WriteTimeout:      "1s",
+// 				},
+// 			},
+// 			HealthCheckServers: []*config.Server{
+// 				{
+// 					Name: "livenesss",
+// 					Host: "127.0.0.1",
+// 					Port: 3000,
+// 				},
+// 				{
+// 					Name: "readiness",
+// 					Host: "127.0.0.1",
+// 					Port: 3001,
+// 				},
+// 			},
+// 			StartUpStrategy: []string{
+// 				"livenesss",
+// 				"pprof",
+// 				"agent-grpc",
+// 				"agent-rest",
+// 				"readiness",
+// 			},
+// 			ShutdownStrategy: []string{
+// 				"readiness",
+// 				"agent-rest",
+// 				"agent-grpc",
+// 				"pprof",
+// 				"livenesss",
+// 			},
+// 			FullShutdownDuration: "30s",
+// 			TLS: &config.TLS{
+// 				Enabled: false,
+// 				Cert:    "/path/to/cert",
+// 				Key:     "/path/to/key",
+// 				CA:      "/path/to/ca",
+// 			},
+// 		},
+// 		NGT: &config.NGT{
+// 			IndexPath:           "/path/to/index",
+// 			Dimension:           4096,
+// 			BulkInsertChunkSize: 10,
+// 			DistanceType:        "l2",
+// 			ObjectType:          "float",
+// 			CreationEdgeSize:    20,
+// 			SearchEdgeSize:      10,
+// 		},
+// 	}
+// 	fmt.Println(config.ToRawYaml(d))
+// }
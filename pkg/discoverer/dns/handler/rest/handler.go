@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package rest provides rest api logic
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vdaas/vald/apis/grpc/discoverer"
+	"github.com/vdaas/vald/apis/grpc/payload"
+)
+
+type Handler interface {
+	Index(w http.ResponseWriter, r *http.Request) error
+	Discover(w http.ResponseWriter, r *http.Request) error
+}
+
+type handler struct {
+	discoverer discoverer.DiscovererServer
+}
+
+func New(opts ...Option) Handler {
+	h := new(handler)
+
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(h)
+	}
+	return h
+}
+
+func (h *handler) Index(w http.ResponseWriter, r *http.Request) error {
+	fmt.Fprint(w, r.URL.String())
+	return nil
+}
+
+func (h *handler) Discover(w http.ResponseWriter, r *http.Request) (err error) {
+	res, err := h.discoverer.Discover(r.Context(), new(payload.Common_Empty))
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Discoverer serves the agents resolved by DNS.
+type Discoverer interface {
+	Start(ctx context.Context) <-chan error
+	Discover(ctx context.Context) (*payload.Info_Agents, error)
+	// Watch calls f with the snapshot of the agents and then with every membership change until the context is canceled.
+	Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error
+	Close() error
+}
+
+// Resolver looks up the DNS records of the agents, which *net.Resolver implements.
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// AgentReady is the state of the resolved agents, which are considered ready to serve.
+const AgentReady = membership.ReadyState
+
+type discoverer struct {
+	name            string
+	service         string
+	proto           string
+	refreshDuration time.Duration
+	resolver        Resolver
+	members         membership.Members
+}
+
+func NewDiscoverer(opts ...DiscovererOption) (Discoverer, error) {
+	d := new(discoverer)
+	for _, opt := range append(defaultDiscovererOpts, opts...) {
+		opt(d)
+	}
+	if d.name == "" {
+		return nil, errors.ErrDNSNameNotFound
+	}
+	d.members = membership.New()
+	return d, nil
+}
+
+// Start resolves the agents at once and then every refresh duration, the failed resolution is reported and the previous agents are kept.
+func (d *discoverer) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	errgroup.Go(safety.RecoverFunc(func() error {
+		defer close(ech)
+
+		t := time.NewTicker(d.refreshDuration)
+		defer t.Stop()
+		for {
+			err := d.resolve(ctx)
+			if err != nil && ctx.Err() == nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+		}
+	}))
+	return ech
+}
+
+// resolve looks up the A records of the SRV record targets, or of the name without service.
+func (d *discoverer) resolve(ctx context.Context) error {
+	hosts := []string{d.name}
+	if d.service != "" {
+		_, srvs, err := d.resolver.LookupSRV(ctx, d.service, d.proto, d.name)
+		if err != nil {
+			return errors.ErrDNSLookupFailed(err, d.name)
+		}
+		hosts = make([]string, 0, len(srvs))
+		for _, srv := range srvs {
+			hosts = append(hosts, strings.TrimSuffix(srv.Target, "."))
+		}
+	}
+
+	var (
+		agents = make([]*payload.Info_Agent, 0, len(hosts))
+		seen   = make(map[string]struct{}, len(hosts))
+		errs   error
+	)
+	for _, host := range hosts {
+		addrs, err := d.resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			errs = errors.Wrap(errs, errors.ErrDNSLookupFailed(err, host).Error())
+			continue
+		}
+		for _, addr := range addrs {
+			ip := addr.IP.To4()
+			if ip == nil {
+				continue
+			}
+			if _, ok := seen[ip.String()]; ok {
+				continue
+			}
+			seen[ip.String()] = struct{}{}
+			agents = append(agents, &payload.Info_Agent{
+				Ip:    ip.String(),
+				State: AgentReady,
+			})
+		}
+	}
+	if errs != nil && len(agents) == 0 {
+		return errs
+	}
+
+	d.members.Set(agents)
+	log.Debugf("dns discoverer resolved %d agents of %s", len(agents), d.name)
+	return errs
+}
+
+func (d *discoverer) Discover(ctx context.Context) (*payload.Info_Agents, error) {
+	return &payload.Info_Agents{
+		Agents: d.members.Agents(),
+	}, nil
+}
+
+func (d *discoverer) Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error {
+	return d.members.Watch(ctx, f)
+}
+
+func (d *discoverer) Close() error {
+	return nil
+}
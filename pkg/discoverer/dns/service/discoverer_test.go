@@ -0,0 +1,151 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+)
+
+type fakeResolver struct {
+	mu    sync.Mutex
+	srvs  map[string][]*net.SRV
+	hosts map[string][]string
+}
+
+func (f *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	srvs, ok := f.srvs["_"+service+"._"+proto+"."+name]
+	if !ok {
+		return "", nil, errors.New("no such host")
+	}
+	return name, srvs, nil
+}
+
+func (f *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ips, ok := f.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	addrs := make([]net.IPAddr, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, net.IPAddr{IP: net.ParseIP(ip)})
+	}
+	return addrs, nil
+}
+
+func ips(agents *payload.Info_Agents) []string {
+	var res []string
+	for _, a := range agents.GetAgents() {
+		res = append(res, a.GetIp())
+	}
+	return res
+}
+
+func TestResolveSRV(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	r := &fakeResolver{
+		srvs: map[string][]*net.SRV{
+			"_grpc._tcp.vald-agent.local": {
+				{Target: "agent-0.vald-agent.local.", Port: 8082},
+				{Target: "agent-1.vald-agent.local.", Port: 8082},
+				{Target: "agent-2.vald-agent.local.", Port: 8082},
+			},
+		},
+		hosts: map[string][]string{
+			"agent-0.vald-agent.local": {"10.0.0.2", "fd00::2"},
+			"agent-1.vald-agent.local": {"10.0.0.1"},
+			"agent-2.vald-agent.local": {"10.0.0.1"},
+		},
+	}
+	d, err := NewDiscoverer(
+		WithDiscovererName("vald-agent.local"),
+		WithDiscovererService("grpc"),
+		WithDiscovererResolver(r),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestResolveSRV(%v)", err)
+	}
+
+	if err := d.(*discoverer).resolve(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: TestResolveSRV(%v)", err)
+	}
+	res, _ := d.Discover(context.Background())
+	if wants := []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(ips(res), wants) {
+		t.Errorf("TestResolveSRV: %v, wanted: %v", ips(res), wants)
+	}
+
+	r.mu.Lock()
+	r.srvs = nil
+	r.mu.Unlock()
+	if err := d.(*discoverer).resolve(context.Background()); err == nil {
+		t.Errorf("TestResolveSRV: wanted the lookup error")
+	}
+	res, _ = d.Discover(context.Background())
+	if wants := []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(ips(res), wants) {
+		t.Errorf("TestResolveSRV: %v, wanted the previous agents %v", ips(res), wants)
+	}
+}
+
+func TestResolveA(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	r := &fakeResolver{
+		hosts: map[string][]string{
+			"vald-agent.local": {"10.0.0.3", "10.0.0.1"},
+		},
+	}
+	d, err := NewDiscoverer(
+		WithDiscovererName("vald-agent.local"),
+		WithDiscovererResolver(r),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestResolveA(%v)", err)
+	}
+
+	if err := d.(*discoverer).resolve(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: TestResolveA(%v)", err)
+	}
+	res, _ := d.Discover(context.Background())
+	if wants := []string{"10.0.0.1", "10.0.0.3"}; !reflect.DeepEqual(ips(res), wants) {
+		t.Errorf("TestResolveA: %v, wanted: %v", ips(res), wants)
+	}
+}
+
+func TestNewDiscovererWithoutName(t *testing.T) {
+	if _, err := NewDiscoverer(); err != errors.ErrDNSNameNotFound {
+		t.Errorf("TestNewDiscovererWithoutName: %v, wanted: %v", err, errors.ErrDNSNameNotFound)
+	}
+}
@@ -0,0 +1,84 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/net/tcp"
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type DiscovererOption func(*discoverer)
+
+var (
+	defaultDiscovererOpts = []DiscovererOption{
+		WithDiscovererProto("tcp"),
+		WithDiscovererRefreshDuration("10s"),
+		WithDiscovererResolver(tcp.NewResolver()),
+	}
+)
+
+func WithDiscovererName(name string) DiscovererOption {
+	return func(d *discoverer) {
+		d.name = name
+	}
+}
+
+func WithDiscovererService(service string) DiscovererOption {
+	return func(d *discoverer) {
+		d.service = service
+	}
+}
+
+func WithDiscovererProto(proto string) DiscovererOption {
+	return func(d *discoverer) {
+		if proto == "" {
+			return
+		}
+		d.proto = proto
+	}
+}
+
+func WithDiscovererRefreshDuration(dur string) DiscovererOption {
+	return func(d *discoverer) {
+		if dur == "" {
+			return
+		}
+		p, err := timeutil.Parse(dur)
+		if err != nil {
+			p = 10 * time.Second
+		}
+		d.refreshDuration = p
+	}
+}
+
+func WithDiscovererResolver(r Resolver) DiscovererOption {
+	return func(d *discoverer) {
+		if r == nil {
+			return
+		}
+		d.resolver = r
+	}
+}
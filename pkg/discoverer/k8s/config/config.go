@@ -36,8 +36,8 @@ type Data struct {
 	// Server represent all server configurations
 	Server *config.Servers `json:"server_config" yaml:"server_config"`
 
-	// NGT represent ngt core configuration
-	NGT *config.NGT `json:"ngt" yaml:"ngt"`
+	// Discoverer represent the agent pods discovery configuration
+	Discoverer *config.Discoverer `json:"discoverer" yaml:"discoverer"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -50,8 +50,10 @@ func NewConfig(path string) (cfg *Data, err error) {
 	if cfg.Server != nil {
 		cfg.Server = cfg.Server.Bind()
 	}
-	if cfg.NGT != nil {
-		cfg.NGT = cfg.NGT.Bind()
+	if cfg.Discoverer != nil {
+		cfg.Discoverer = cfg.Discoverer.Bind()
+	} else {
+		cfg.Discoverer = new(config.Discoverer)
 	}
 
 	return cfg, nil
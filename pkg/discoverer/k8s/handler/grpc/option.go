@@ -31,8 +31,8 @@ var (
 	defaultOpts = []Option{}
 )
 
-func WithNGT(n service.NGT) Option {
+func WithDiscoverer(d service.Discoverer) Option {
 	return func(s *server) {
-		s.ngt = n
+		s.discoverer = d
 	}
 }
@@ -23,7 +23,7 @@
 // Package rest provides rest api logic
 package rest
 
-import "github.com/vdaas/vald/apis/grpc/agent"
+import "github.com/vdaas/vald/apis/grpc/discoverer"
 
 type Option func(*handler)
 
@@ -31,8 +31,8 @@ var (
 	defaultOpts = []Option{}
 )
 
-func WithAgent(a agent.AgentServer) Option {
+func WithDiscoverer(d discoverer.DiscovererServer) Option {
 	return func(h *handler) {
-		h.agent = a
+		h.discoverer = d
 	}
 }
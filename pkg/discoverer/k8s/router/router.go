@@ -57,97 +57,12 @@ func New(opts ...Option) http.Handler {
 				h.Index,
 			},
 			{
-				"Search",
-				[]string{
-					http.MethodPost,
-				},
-				"/search",
-				h.Search,
-			},
-			{
-				"Search By ID",
-				[]string{
-					http.MethodGet,
-				},
-				"/search/{id}",
-				h.SearchByID,
-			},
-			{
-				"Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert",
-				h.Insert,
-			},
-			{
-				"Multiple Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert/multi",
-				h.MultiInsert,
-			},
-			{
-				"Update",
-				[]string{
-					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
-				},
-				"/update",
-				h.Update,
-			},
-			{
-				"Multiple Update",
-				[]string{
-					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
-				},
-				"/update/multi",
-				h.MultiUpdate,
-			},
-			{
-				"Remove",
-				[]string{
-					http.MethodDelete,
-				},
-				"/delete/{id}",
-				h.Remove,
-			},
-			{
-				"Multiple Remove",
-				[]string{
-					http.MethodDelete,
-					http.MethodPost,
-				},
-				"/delete/multi",
-				h.MultiRemove,
-			},
-			{
-				"Create Index",
-				[]string{
-					http.MethodGet,
-				},
-				"/index/create/{pool}",
-				h.CreateIndex,
-			},
-			{
-				"Save Index",
-				[]string{
-					http.MethodGet,
-				},
-				"/index/save",
-				h.SaveIndex,
-			},
-			{
-				"GetObject",
+				"Discover",
 				[]string{
 					http.MethodGet,
 				},
-				"/object/{id}",
-				h.GetObject,
+				"/discover",
+				h.Discover,
 			},
 		}...),
 		routing.WithTimeout(r.timeout))
@@ -0,0 +1,288 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	igrpc "github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/safety"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Discoverer lists the agent pods watched through the kubernetes API server.
+type Discoverer interface {
+	Start(ctx context.Context) <-chan error
+	Discover(ctx context.Context) (*payload.Info_Agents, error)
+	// Watch calls f with the snapshot of the agents and then with every membership change until the context is canceled.
+	Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error
+	Close() error
+}
+
+const (
+	// AgentReady is the state of the running agent pod passing its readiness probe.
+	AgentReady = membership.ReadyState
+	// AgentNotReady is the state of the running agent pod failing its readiness probe.
+	AgentNotReady = "NotReady"
+)
+
+type discoverer struct {
+	client        kubernetes.Interface
+	kubeconfig    string
+	namespace     string
+	labelSelector string
+	resyncPeriod  time.Duration
+	agentPort     int
+	countObjects  bool
+	countTimeout  time.Duration
+	dialOpts      []grpc.DialOption
+
+	selector labels.Selector
+	factory  informers.SharedInformerFactory
+	lister   listers.PodLister
+	synced   cache.InformerSynced
+	pool     igrpc.Pool
+	members  membership.Members
+}
+
+func NewDiscoverer(opts ...DiscovererOption) (Discoverer, error) {
+	d := new(discoverer)
+	for _, opt := range append(defaultDiscovererOpts, opts...) {
+		opt(d)
+	}
+
+	var err error
+	d.selector, err = labels.Parse(d.labelSelector)
+	if err != nil {
+		return nil, errors.ErrInvalidLabelSelector(err, d.labelSelector)
+	}
+
+	if d.client == nil {
+		var cfg *rest.Config
+		if d.kubeconfig != "" {
+			cfg, err = clientcmd.BuildConfigFromFlags("", d.kubeconfig)
+		} else {
+			cfg, err = rest.InClusterConfig()
+		}
+		if err != nil {
+			return nil, errors.ErrKubernetesClientInitFailed(err)
+		}
+		d.client, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, errors.ErrKubernetesClientInitFailed(err)
+		}
+	}
+
+	d.factory = informers.NewSharedInformerFactoryWithOptions(d.client, d.resyncPeriod,
+		informers.WithNamespace(d.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = d.selector.String()
+		}))
+	pods := d.factory.Core().V1().Pods()
+	d.lister = pods.Lister()
+	d.synced = pods.Informer().HasSynced
+	pods.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) {
+			d.refresh()
+		},
+		UpdateFunc: func(interface{}, interface{}) {
+			d.refresh()
+		},
+		DeleteFunc: func(interface{}) {
+			d.refresh()
+		},
+	})
+	d.pool = igrpc.NewPool(d.dialOpts...)
+	d.members = membership.New()
+
+	return d, nil
+}
+
+// Start runs the pod informer until the context is canceled.
+func (d *discoverer) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	d.factory.Start(ctx.Done())
+	errgroup.Go(safety.RecoverFunc(func() error {
+		defer close(ech)
+		if !cache.WaitForCacheSync(ctx.Done(), d.synced) {
+			select {
+			case <-ctx.Done():
+			case ech <- errors.ErrInformerCacheNotSynced:
+			}
+			return nil
+		}
+		d.refresh()
+		<-ctx.Done()
+		return nil
+	}))
+	return ech
+}
+
+// Discover returns the agent pods having an ip sorted by the ip, with the object count of the ready ones when CountObjects is enabled.
+func (d *discoverer) Discover(ctx context.Context) (*payload.Info_Agents, error) {
+	if !d.synced() {
+		return nil, errors.ErrInformerCacheNotSynced
+	}
+	agents, err := d.agents()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.countObjects {
+		err = d.count(ctx, agents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &payload.Info_Agents{
+		Agents: agents,
+	}, nil
+}
+
+func (d *discoverer) Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error {
+	if !d.synced() {
+		return errors.ErrInformerCacheNotSynced
+	}
+	return d.members.Watch(ctx, f)
+}
+
+// agents returns the agents of the pods having an ip sorted by the ip.
+func (d *discoverer) agents() ([]*payload.Info_Agent, error) {
+	pods, err := d.lister.List(d.selector)
+	if err != nil {
+		return nil, err
+	}
+
+	agents := make([]*payload.Info_Agent, 0, len(pods))
+	for _, pod := range pods {
+		if a := toAgent(pod); a != nil {
+			agents = append(agents, a)
+		}
+	}
+	sort.Slice(agents, func(i, j int) bool {
+		return agents[i].GetIp() < agents[j].GetIp()
+	})
+	return agents, nil
+}
+
+// refresh applies the pods of the informer cache to the members, which publishes the changes to the watchers.
+func (d *discoverer) refresh() {
+	agents, err := d.agents()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	d.members.Set(agents)
+}
+
+// count asks the ready agents for their stored object count, the failures are reported as the agent errors.
+func (d *discoverer) count(ctx context.Context, agents []*payload.Info_Agent) error {
+	addrs := make([]string, 0, len(agents))
+	for _, a := range agents {
+		if a.GetState() == AgentReady {
+			addrs = append(addrs, d.addr(a))
+		}
+	}
+	err := d.pool.Update(ctx, addrs)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.countTimeout)
+	defer cancel()
+
+	_, eg := errgroup.New(ctx)
+	for _, a := range agents {
+		a := a
+		conn, ok := d.pool.Get(d.addr(a))
+		if !ok {
+			continue
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			info, err := agent.NewAgentClient(conn).IndexInfo(ctx, new(payload.Controll_IndexRequest))
+			if err != nil {
+				a.Error = &payload.Common_Error{
+					Msg:       err.Error(),
+					Timestamp: time.Now().UnixNano(),
+				}
+				return nil
+			}
+			a.Count = uint32(info.GetStored())
+			return nil
+		}))
+	}
+	return eg.Wait()
+}
+
+func (d *discoverer) addr(a *payload.Info_Agent) string {
+	return net.JoinHostPort(a.GetIp(), strconv.Itoa(d.agentPort))
+}
+
+func (d *discoverer) Close() error {
+	return d.pool.Close()
+}
+
+// toAgent returns the agent of the pod, or nil for the pod without ip or being deleted.
+func toAgent(pod *corev1.Pod) *payload.Info_Agent {
+	if pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+		return nil
+	}
+	return &payload.Info_Agent{
+		Ip:    pod.Status.PodIP,
+		State: state(pod),
+	}
+}
+
+// state returns the pod phase, or the readiness of the running pod.
+func state(pod *corev1.Pod) string {
+	if pod.Status.Phase != corev1.PodRunning {
+		return string(pod.Status.Phase)
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			return AgentReady
+		}
+	}
+	return AgentNotReady
+}
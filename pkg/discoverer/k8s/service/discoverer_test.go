@@ -0,0 +1,236 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/net/grpc/grpctest"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeAgent struct {
+	agent.UnimplementedAgentServer
+	stored uint64
+}
+
+func (f *fakeAgent) IndexInfo(ctx context.Context, req *payload.Controll_IndexRequest) (*payload.Info_Index, error) {
+	return &payload.Info_Index{
+		Stored: f.stored,
+	}, nil
+}
+
+func pod(name, ns, app, ip string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels: map[string]string{
+				"app": app,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			PodIP: ip,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: status},
+			},
+		},
+	}
+}
+
+func waitAgents(t *testing.T, d Discoverer, n int) []*payload.Info_Agent {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		res, err := d.Discover(context.Background())
+		if err == nil && len(res.GetAgents()) == n {
+			return res.GetAgents()
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("waitAgents: %v, %v, wanted %d agents", res, err, n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDiscoverWatchesAgentPods(t *testing.T) {
+	srv, err := grpctest.NewServer(func(srv *grpc.Server) {
+		agent.RegisterAgentServer(srv, &fakeAgent{stored: 42})
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: NewServer(%v)", err)
+	}
+	defer srv.Stop()
+	port := srv.Port
+
+	client := fake.NewSimpleClientset(
+		pod("agent-0", "vald", "agent", "127.0.0.1", corev1.PodRunning, true),
+		pod("agent-1", "vald", "agent", "10.0.0.2", corev1.PodRunning, false),
+		pod("agent-2", "vald", "agent", "", corev1.PodPending, false),
+		pod("gateway-0", "vald", "gateway", "10.0.0.4", corev1.PodRunning, true),
+		pod("agent-0", "other", "agent", "10.0.0.5", corev1.PodRunning, true),
+	)
+
+	d, err := NewDiscoverer(
+		WithDiscovererClient(client),
+		WithDiscovererNamespace("vald"),
+		WithDiscovererLabelSelector("app=agent"),
+		WithDiscovererAgentPort(port),
+		WithDiscovererCountObjects(true),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestDiscoverWatchesAgentPods(%v)", err)
+	}
+	defer d.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errgroup.Init(ctx)
+	d.Start(ctx)
+
+	agents := waitAgents(t, d, 2)
+	if agents[0].GetIp() != "10.0.0.2" || agents[0].GetState() != AgentNotReady || agents[0].GetCount() != 0 {
+		t.Errorf("TestDiscoverWatchesAgentPods: %v, wanted not ready agent 10.0.0.2", agents[0])
+	}
+	if agents[1].GetIp() != "127.0.0.1" || agents[1].GetState() != AgentReady || agents[1].GetCount() != 42 {
+		t.Errorf("TestDiscoverWatchesAgentPods: %v, wanted ready agent 127.0.0.1 storing 42 objects", agents[1])
+	}
+
+	_, err = client.CoreV1().Pods("vald").Create(pod("agent-3", "vald", "agent", "10.0.0.3", corev1.PodRunning, true))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestDiscoverWatchesAgentPods(%v)", err)
+	}
+	agents = waitAgents(t, d, 3)
+	var ips []string
+	for _, a := range agents {
+		ips = append(ips, a.GetIp())
+	}
+	if wants := []string{"10.0.0.2", "10.0.0.3", "127.0.0.1"}; !reflect.DeepEqual(ips, wants) {
+		t.Errorf("TestDiscoverWatchesAgentPods: %v, wanted: %v", ips, wants)
+	}
+	if agents[1].GetError() == nil {
+		t.Errorf("TestDiscoverWatchesAgentPods: %v, wanted the count error of the unreachable agent", agents[1])
+	}
+}
+
+func TestDiscoverBeforeSync(t *testing.T) {
+	d, err := NewDiscoverer(WithDiscovererClient(fake.NewSimpleClientset()))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestDiscoverBeforeSync(%v)", err)
+	}
+	defer d.Close()
+	if _, err := d.Discover(context.Background()); err == nil {
+		t.Errorf("TestDiscoverBeforeSync: wanted an error before the informer started")
+	}
+}
+
+func TestNewDiscovererInvalidSelector(t *testing.T) {
+	_, err := NewDiscoverer(
+		WithDiscovererClient(fake.NewSimpleClientset()),
+		WithDiscovererLabelSelector("app in ("),
+	)
+	if err == nil {
+		t.Errorf("TestNewDiscovererInvalidSelector: wanted an error")
+	}
+}
+
+func TestWatchSendsSnapshotAndChanges(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		pod("agent-0", "vald", "agent", "10.0.0.1", corev1.PodRunning, true),
+	)
+	d, err := NewDiscoverer(
+		WithDiscovererClient(client),
+		WithDiscovererNamespace("vald"),
+		WithDiscovererLabelSelector("app=agent"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestWatchSendsSnapshotAndChanges(%v)", err)
+	}
+	defer d.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errgroup.Init(ctx)
+	d.Start(ctx)
+	waitAgents(t, d, 1)
+
+	evs := make(chan *payload.Info_AgentEvent, 10)
+	go d.Watch(ctx, func(ev *payload.Info_AgentEvent) error {
+		evs <- ev
+		return nil
+	})
+	next := func() *payload.Info_AgentEvent {
+		select {
+		case ev := <-evs:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TestWatchSendsSnapshotAndChanges: no event")
+		}
+		return nil
+	}
+
+	ev := next()
+	if ev.GetType() != payload.Info_AgentEvent_SNAPSHOT || len(ev.GetSnapshot().GetAgents()) != 1 ||
+		ev.GetSnapshot().GetAgents()[0].GetIp() != "10.0.0.1" {
+		t.Fatalf("TestWatchSendsSnapshotAndChanges: %v, wanted the snapshot of 10.0.0.1", ev)
+	}
+
+	pods := client.CoreV1().Pods("vald")
+	p := pod("agent-1", "vald", "agent", "10.0.0.2", corev1.PodRunning, false)
+	if _, err := pods.Create(p); err != nil {
+		t.Fatalf("Unexpected error: TestWatchSendsSnapshotAndChanges(%v)", err)
+	}
+	if ev = next(); ev.GetType() != payload.Info_AgentEvent_ADD || ev.GetAgent().GetIp() != "10.0.0.2" ||
+		ev.GetAgent().GetState() != AgentNotReady {
+		t.Errorf("TestWatchSendsSnapshotAndChanges: %v, wanted adding not ready 10.0.0.2", ev)
+	}
+
+	p = pod("agent-1", "vald", "agent", "10.0.0.2", corev1.PodRunning, true)
+	if _, err := pods.Update(p); err != nil {
+		t.Fatalf("Unexpected error: TestWatchSendsSnapshotAndChanges(%v)", err)
+	}
+	if ev = next(); ev.GetType() != payload.Info_AgentEvent_UPDATE || ev.GetAgent().GetState() != AgentReady {
+		t.Errorf("TestWatchSendsSnapshotAndChanges: %v, wanted updating 10.0.0.2 to ready", ev)
+	}
+
+	if err := pods.Delete("agent-0", nil); err != nil {
+		t.Fatalf("Unexpected error: TestWatchSendsSnapshotAndChanges(%v)", err)
+	}
+	if ev = next(); ev.GetType() != payload.Info_AgentEvent_REMOVE || ev.GetAgent().GetIp() != "10.0.0.1" {
+		t.Errorf("TestWatchSendsSnapshotAndChanges: %v, wanted removing 10.0.0.1", ev)
+	}
+}
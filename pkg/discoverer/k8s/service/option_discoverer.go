@@ -0,0 +1,115 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/kubernetes"
+)
+
+type DiscovererOption func(*discoverer)
+
+var (
+	defaultDiscovererOpts = []DiscovererOption{
+		WithDiscovererResyncPeriod("30s"),
+		WithDiscovererAgentPort(8082),
+		WithDiscovererCountTimeout("1s"),
+		WithDiscovererDialOptions(grpc.WithInsecure()),
+	}
+)
+
+// WithDiscovererClient sets the kubernetes client instead of building it from the kubeconfig.
+func WithDiscovererClient(c kubernetes.Interface) DiscovererOption {
+	return func(d *discoverer) {
+		d.client = c
+	}
+}
+
+func WithDiscovererKubeconfig(path string) DiscovererOption {
+	return func(d *discoverer) {
+		d.kubeconfig = path
+	}
+}
+
+func WithDiscovererNamespace(ns string) DiscovererOption {
+	return func(d *discoverer) {
+		d.namespace = ns
+	}
+}
+
+func WithDiscovererLabelSelector(selector string) DiscovererOption {
+	return func(d *discoverer) {
+		d.labelSelector = selector
+	}
+}
+
+func WithDiscovererResyncPeriod(dur string) DiscovererOption {
+	return func(d *discoverer) {
+		if dur == "" {
+			return
+		}
+		p, err := timeutil.Parse(dur)
+		if err != nil {
+			p = 30 * time.Second
+		}
+		d.resyncPeriod = p
+	}
+}
+
+func WithDiscovererAgentPort(port int) DiscovererOption {
+	return func(d *discoverer) {
+		if port <= 0 {
+			return
+		}
+		d.agentPort = port
+	}
+}
+
+func WithDiscovererCountObjects(enabled bool) DiscovererOption {
+	return func(d *discoverer) {
+		d.countObjects = enabled
+	}
+}
+
+func WithDiscovererCountTimeout(dur string) DiscovererOption {
+	return func(d *discoverer) {
+		if dur == "" {
+			return
+		}
+		t, err := timeutil.Parse(dur)
+		if err != nil {
+			t = time.Second
+		}
+		d.countTimeout = t
+	}
+}
+
+func WithDiscovererDialOptions(opts ...grpc.DialOption) DiscovererOption {
+	return func(d *discoverer) {
+		d.dialOpts = opts
+	}
+}
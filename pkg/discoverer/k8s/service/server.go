@@ -28,7 +28,7 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/vdaas/vald/apis/grpc/agent"
+	pb "github.com/vdaas/vald/apis/grpc/discoverer"
 	"github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/net/http/metrics"
 	"github.com/vdaas/vald/internal/servers"
@@ -42,7 +42,7 @@ type Server servers.Listener
 type srvs struct {
 	rest http.Handler
 	gql  http.Handler
-	grpc agent.AgentServer
+	grpc pb.DiscovererServer
 	cfg  *config.Servers
 }
 
@@ -129,7 +129,7 @@ func (s *srvs) setupAPIs(cfg *tls.Config) ([]servers.Option, error) {
 			srv, err := server.New(
 				append(sc.Opts(),
 					server.WithGRPCRegistFunc(func(gsrv *grpc.Server) {
-						agent.RegisterAgentServer(gsrv, s.grpc)
+						pb.RegisterDiscovererServer(gsrv, s.grpc)
 					}),
 					server.WithGRPCOption(gopts[:len(gopts)]...),
 
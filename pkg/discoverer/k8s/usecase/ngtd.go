@@ -3,7 +3,9 @@ package usecase
 import (
 	"context"
 
+	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/pkg/discoverer/k8s/config"
 	"github.com/vdaas/vald/pkg/discoverer/k8s/handler/grpc"
 	"github.com/vdaas/vald/pkg/discoverer/k8s/handler/rest"
@@ -14,16 +16,25 @@ import (
 type Runner runner.Runner
 
 type run struct {
-	cfg    *config.Data
-	server service.Server
+	cfg        *config.Data
+	server     service.Server
+	discoverer service.Discoverer
 }
 
 func New(cfg *config.Data) (Runner, error) {
-	ngt, err := service.NewNGT(cfg.NGT)
+	dsc, err := service.NewDiscoverer(
+		service.WithDiscovererKubeconfig(cfg.Discoverer.Kubeconfig),
+		service.WithDiscovererNamespace(cfg.Discoverer.Namespace),
+		service.WithDiscovererLabelSelector(cfg.Discoverer.LabelSelector),
+		service.WithDiscovererResyncPeriod(cfg.Discoverer.ResyncPeriod),
+		service.WithDiscovererAgentPort(cfg.Discoverer.AgentPort),
+		service.WithDiscovererCountObjects(cfg.Discoverer.CountObjects),
+		service.WithDiscovererCountTimeout(cfg.Discoverer.CountTimeout),
+	)
 	if err != nil {
 		return nil, err
 	}
-	g := grpc.New(grpc.WithNGT(ngt))
+	g := grpc.New(grpc.WithDiscoverer(dsc))
 
 	srv, err := service.NewServer(
 		service.WithConfig(cfg.Server),
@@ -31,7 +42,7 @@ func New(cfg *config.Data) (Runner, error) {
 			router.New(
 				router.WithHandler(
 					rest.New(
-						rest.WithAgent(g),
+						rest.WithDiscoverer(g),
 					),
 				),
 			),
@@ -41,12 +52,14 @@ func New(cfg *config.Data) (Runner, error) {
 	)
 
 	if err != nil {
+		dsc.Close()
 		return nil, err
 	}
 
 	return &run{
-		cfg:    cfg,
-		server: srv,
+		cfg:        cfg,
+		server:     srv,
+		discoverer: dsc,
 	}, nil
 }
 
@@ -55,7 +68,36 @@ func (r *run) PreStart() error {
 }
 
 func (r *run) Start(ctx context.Context) <-chan error {
-	return r.server.ListenAndServe(ctx)
+	ech := make(chan error)
+	sech := r.server.ListenAndServe(ctx)
+	dech := r.discoverer.Start(ctx)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		var ok bool
+		for sech != nil || dech != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err, ok = <-sech:
+				if !ok {
+					sech = nil
+				}
+			case err, ok = <-dech:
+				if !ok {
+					dech = nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		return nil
+	}))
+	return ech
 }
 
 func (r *run) PreStop() error {
@@ -63,5 +105,9 @@ func (r *run) PreStop() error {
 }
 
 func (r *run) Stop(ctx context.Context) error {
-	return r.server.Shutdown(ctx)
+	err := r.server.Shutdown(ctx)
+	if cerr := r.discoverer.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
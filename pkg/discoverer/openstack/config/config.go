@@ -23,7 +23,9 @@
 // Package setting stores all server application settings
 package config
 
-import "github.com/vdaas/vald/internal/config"
+import (
+	"github.com/vdaas/vald/internal/config"
+)
 
 // Config represent a application setting data content (config.yaml).
 // In K8s environment, this configuration is stored in K8s ConfigMap.
@@ -31,18 +33,11 @@ type Data struct {
 	// Version represent configuration file version.
 	Version string `json:"version" yaml:"version"`
 
-	// ServerConfig represent all server configurations
-	ServerConfig *config.Servers `json:"server_config" yaml:"server_config"`
+	// Server represent all server configurations
+	Server *config.Servers `json:"server_config" yaml:"server_config"`
 
-	// Debug represent server debug flags
-	Debug *config.Debug `json:"debug" yaml:"debug"`
-
-	// NGT represent ngt core configuration
-	NGT *config.NGT `json:"ngt" yaml:"ngt"`
-}
-
-func GetVersion() string {
-	return config.GetVersion()
+	// OpenStack represent the OpenStack compute API and the metadata of the agent servers
+	OpenStack *config.OpenStackDiscoverer `json:"openstack" yaml:"openstack"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -52,9 +47,101 @@ func NewConfig(path string) (cfg *Data, err error) {
 		return nil, err
 	}
 
-	cfg.ServerConfig.Bind()
-	cfg.Debug.Bind()
-	cfg.NGT.Bind()
+	if cfg.Server != nil {
+		cfg.Server = cfg.Server.Bind()
+	}
+	if cfg.OpenStack != nil {
+		cfg.OpenStack = cfg.OpenStack.Bind()
+	} else {
+		cfg.OpenStack = new(config.OpenStackDiscoverer).Bind()
+	}
 
 	return cfg, nil
 }
+
+// func FakeData() {
+// 	d := Data{
+// 		Version: "v0.0.1",
+// 		Server: &config.Servers{
+// 			Servers: []*config.Server{
+// 				{
+// 					Name:              "agent-rest",
+// 					Host:              "127.0.0.1",
+// 					Port:              8080,
+// 					Mode:              "REST",
+// 					ProbeWaitTime:     "3s",
+// 					ShutdownDuration:  "5s",
+// 					HandlerTimeout:    "5s",
+// 					IdleTimeout:       "2s",
+// 					ReadHeaderTimeout: "1s",
+// 					ReadTimeout:       "1s",
+// 					WriteTimeout:      "1s",
+// 				},
+// 				{
+// 					Name: "agent-grpc",
+// 					Host: "127.0.0.1",
+// 					Port: 8082,
+// 					Mode: "GRPC",
+// 				},
+// 			},
+// 			MetricsServers: []*config.Server{
+// 				{
+// 					Name:              "pprof",
+// 					Host:              "127.0.0.1",
+// 					Port:              6060,
+// 					Mode:              "REST",
+// 					ProbeWaitTime:     "3s",
+// 					ShutdownDuration:  "5s",
+// 					HandlerTimeout:    "5s",
+// 					IdleTimeout:       "2s",
+// 					ReadHeaderTimeout: "1s",
+// 					ReadTimeout:       "1s",
+// 					WriteTimeout:      "1s",
+// 				},
+// 			},
+// 			HealthCheckServers: []*config.Server{
+// 				{
+// 					Name: "livenesss",
+// 					Host: "127.0.0.1",
+// 					Port: 3000,
+// 				},
+// 				{
+// 					Name: "readiness",
+// 					Host: "127.0.0.1",
+// 					Port: 3001,
+// 				},
+// 			},
+// 			StartUpStrategy: []string{
+// 				"livenesss",
+// 				"pprof",
+// 				"agent-grpc",
+// 				"agent-rest",
+// 				"readiness",
+// 			},
+// 			ShutdownStrategy: []string{
+// 				"readiness",
+// 				"agent-rest",
+// 				"agent-grpc",
+// 				"pprof",
+// 				"livenesss",
+// 			},
+// 			FullShutdownDuration: "30s",
+// 			TLS: &config.TLS{
+// 				Enabled: false,
+// 				Cert:    "/path/to/cert",
+// 				Key:     "/path/to/key",
+// 				CA:      "/path/to/ca",
+// 			},
+// 		},
+// 		NGT: &config.NGT{
+// 			IndexPath:           "/path/to/index",
+// 			Dimension:           4096,
+// 			BulkInsertChunkSize: 10,
+// 			DistanceType:        "l2",
+// 			ObjectType:          "float",
+// 			CreationEdgeSize:    20,
+// 			SearchEdgeSize:      10,
+// 		},
+// 	}
+// 	fmt.Println(config.ToRawYaml(d))
+// }
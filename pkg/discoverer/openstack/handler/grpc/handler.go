@@ -1,20 +1,55 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package grpc provides grpc server logic
 package grpc
 
-import "google.golang.org/grpc"
+import (
+	"context"
+
+	"github.com/vdaas/vald/apis/grpc/discoverer"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/pkg/discoverer/openstack/service"
+)
 
-type Handler interface {
-	// GRPC interface here
-	GetGRPCServer() *grpc.Server
+type Server discoverer.DiscovererServer
+
+type server struct {
+	discoverer service.Discoverer
 }
 
-type handler struct {
-	gs *grpc.Server
+func New(opts ...Option) Server {
+	s := new(server)
+
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(s)
+	}
+	return s
 }
 
-func New() Handler {
-	return nil
+func (s *server) Discover(ctx context.Context, _ *payload.Common_Empty) (*payload.Info_Agents, error) {
+	return s.discoverer.Discover(ctx)
 }
 
-func (h *handler) GetGRPCServer() *grpc.Server {
-	return h.gs
+func (s *server) Watch(_ *payload.Common_Empty, stream discoverer.Discoverer_WatchServer) error {
+	return s.discoverer.Watch(stream.Context(), stream.Send)
 }
@@ -20,37 +20,34 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
 
-// Package setting stores all server application settings
+// Package rest provides rest api logic
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
-	"github.com/vdaas/vald/pkg/discoverer/openstack/service"
+	"github.com/vdaas/vald/apis/grpc/discoverer"
+	"github.com/vdaas/vald/apis/grpc/payload"
 )
 
 type Handler interface {
 	Index(w http.ResponseWriter, r *http.Request) error
-	Search(w http.ResponseWriter, r *http.Request) error
-	SearchByID(w http.ResponseWriter, r *http.Request) error
-	Insert(w http.ResponseWriter, r *http.Request) error
-	MultiInsert(w http.ResponseWriter, r *http.Request) error
-	Update(w http.ResponseWriter, r *http.Request) error
-	MultiUpdate(w http.ResponseWriter, r *http.Request) error
-	Remove(w http.ResponseWriter, r *http.Request) error
-	MultiRemove(w http.ResponseWriter, r *http.Request) error
-	CreateIndex(w http.ResponseWriter, r *http.Request) error
-	SaveIndex(w http.ResponseWriter, r *http.Request) error
-	GetObject(w http.ResponseWriter, r *http.Request) error
+	Discover(w http.ResponseWriter, r *http.Request) error
 }
 
 type handler struct {
-	ngt service.NGT
+	discoverer discoverer.DiscovererServer
 }
 
-func New() Handler {
-	return &handler{}
+func New(opts ...Option) Handler {
+	h := new(handler)
+
+	for _, opt := range append(defaultOpts, opts...) {
+		opt(h)
+	}
+	return h
 }
 
 func (h *handler) Index(w http.ResponseWriter, r *http.Request) error {
@@ -58,46 +55,14 @@ func (h *handler) Index(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
-func (h *handler) Search(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) SearchByID(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) Insert(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) MultiInsert(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) Update(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) MultiUpdate(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) Remove(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) MultiRemove(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) CreateIndex(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) SaveIndex(w http.ResponseWriter, r *http.Request) error {
-	return nil
-}
-
-func (h *handler) GetObject(w http.ResponseWriter, r *http.Request) error {
+func (h *handler) Discover(w http.ResponseWriter, r *http.Request) (err error) {
+	res, err := h.discoverer.Discover(r.Context(), new(payload.Common_Empty))
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		return err
+	}
 	return nil
 }
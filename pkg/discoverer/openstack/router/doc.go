@@ -1,7 +0,0 @@
-/*
-Package router manages to handle the routing of all HTTP requests to corresponding handler.
-The authenticate request is defined as path "/authn" and only accept HTTP POST request.
-The authorization request is defined as path "/authz" and only accept HTTP POST request.
-
-*/
-package router
@@ -0,0 +1,192 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Discoverer serves the agent servers listed by the OpenStack compute API.
+type Discoverer interface {
+	Start(ctx context.Context) <-chan error
+	Discover(ctx context.Context) (*payload.Info_Agents, error)
+	// Watch calls f with the snapshot of the agents and then with every membership change until the context is canceled.
+	Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error
+	Close() error
+}
+
+// AgentReady is the state of the ACTIVE agent servers, the other servers are served with their server status.
+const AgentReady = membership.ReadyState
+
+type discoverer struct {
+	authURL           string
+	username          string
+	password          string
+	userDomainName    string
+	projectName       string
+	projectDomainName string
+	region            string
+	endpointInterface string
+	metadataKey       string
+	metadataValue     string
+	network           string
+	refreshDuration   time.Duration
+	client            *http.Client
+
+	mu         sync.Mutex
+	token      string
+	expiresAt  time.Time
+	computeURL string
+
+	members membership.Members
+}
+
+func NewDiscoverer(opts ...DiscovererOption) (Discoverer, error) {
+	d := new(discoverer)
+	for _, opt := range append(defaultDiscovererOpts, opts...) {
+		opt(d)
+	}
+	if d.authURL == "" {
+		return nil, errors.ErrOpenStackAuthURLNotFound
+	}
+	d.members = membership.New()
+	return d, nil
+}
+
+// Start lists the agent servers at once and then every refresh duration, the failed listing is reported and the previous agents are kept.
+func (d *discoverer) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	errgroup.Go(safety.RecoverFunc(func() error {
+		defer close(ech)
+
+		t := time.NewTicker(d.refreshDuration)
+		defer t.Stop()
+		for {
+			err := d.list(ctx)
+			if err != nil && ctx.Err() == nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+		}
+	}))
+	return ech
+}
+
+// list publishes one fixed ipv4 address of each server which has the agent metadata.
+func (d *discoverer) list(ctx context.Context) error {
+	srvs, err := d.servers(ctx)
+	if err != nil {
+		return errors.ErrOpenStackListServersFailed(err)
+	}
+
+	agents := make([]*payload.Info_Agent, 0, len(srvs))
+	seen := make(map[string]struct{}, len(srvs))
+	for _, srv := range srvs {
+		if d.metadataKey != "" {
+			v, ok := srv.Metadata[d.metadataKey]
+			if !ok || (d.metadataValue != "" && v != d.metadataValue) {
+				continue
+			}
+		}
+		ip := d.addr(srv)
+		if ip == "" {
+			continue
+		}
+		if _, ok := seen[ip]; ok {
+			continue
+		}
+		seen[ip] = struct{}{}
+		state := srv.Status
+		if state == novaServerActive {
+			state = AgentReady
+		}
+		agents = append(agents, &payload.Info_Agent{
+			Ip:    ip,
+			State: state,
+		})
+	}
+
+	d.members.Set(agents)
+	log.Debugf("openstack discoverer listed %d agents of %d servers", len(agents), len(srvs))
+	return nil
+}
+
+// addr returns the first fixed ipv4 address of the server on the configured network,
+// or on the networks in name order when no network is configured, so that a server is served once.
+func (d *discoverer) addr(srv novaServer) string {
+	networks := make([]string, 0, len(srv.Addresses))
+	for network := range srv.Addresses {
+		if d.network == "" || network == d.network {
+			networks = append(networks, network)
+		}
+	}
+	sort.Strings(networks)
+	for _, network := range networks {
+		for _, addr := range srv.Addresses[network] {
+			if addr.Version != 4 || (addr.Type != "" && addr.Type != novaAddressFixed) {
+				continue
+			}
+			ip := net.ParseIP(addr.Addr).To4()
+			if ip != nil {
+				return ip.String()
+			}
+		}
+	}
+	return ""
+}
+
+func (d *discoverer) Discover(ctx context.Context) (*payload.Info_Agents, error) {
+	return &payload.Info_Agents{
+		Agents: d.members.Agents(),
+	}, nil
+}
+
+func (d *discoverer) Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error {
+	return d.members.Watch(ctx, f)
+}
+
+func (d *discoverer) Close() error {
+	d.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,271 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/log"
+)
+
+// fakeOpenStack serves the Keystone token API and the Nova server listing in two pages.
+type fakeOpenStack struct {
+	mu      sync.Mutex
+	tokens  int
+	revoked bool
+	servers []map[string]interface{}
+	srv     *httptest.Server
+}
+
+func newFakeOpenStack(t *testing.T) *fakeOpenStack {
+	f := new(fakeOpenStack)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/identity/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		var req authRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid auth request: %v", err)
+		}
+		user := req.Auth.Identity.Password.User
+		if user.Name != "vald" || user.Password != "secret" || user.Domain.Name != "Default" ||
+			req.Auth.Scope == nil || req.Auth.Scope.Project.Name != "vald" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.mu.Lock()
+		f.tokens++
+		f.revoked = false
+		f.mu.Unlock()
+		w.Header().Set("X-Subject-Token", "token")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"token": map[string]interface{}{
+				"expires_at": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+				"catalog": []map[string]interface{}{
+					{
+						"type": "identity",
+						"endpoints": []map[string]string{
+							{"interface": "public", "region": "RegionOne", "url": f.srv.URL + "/identity/v3"},
+						},
+					},
+					{
+						"type": "compute",
+						"endpoints": []map[string]string{
+							{"interface": "internal", "region": "RegionOne", "url": "http://127.0.0.1:1/compute/v2.1"},
+							{"interface": "public", "region": "RegionTwo", "url": "http://127.0.0.1:1/compute/v2.1"},
+							{"interface": "public", "region": "RegionOne", "url": f.srv.URL + "/compute/v2.1"},
+						},
+					},
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/compute/v2.1/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if r.Header.Get("X-Auth-Token") != "token" || f.revoked {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		res := map[string]interface{}{}
+		half := len(f.servers) / 2
+		if r.URL.Query().Get("marker") == "" {
+			res["servers"] = f.servers[:half]
+			res["servers_links"] = []map[string]string{
+				{"rel": "next", "href": f.srv.URL + "/compute/v2.1/servers/detail?marker=page"},
+			}
+		} else {
+			res["servers"] = f.servers[half:]
+		}
+		json.NewEncoder(w).Encode(res)
+	})
+	f.srv = httptest.NewServer(mux)
+	return f
+}
+
+func novaFixture(status, tag string, addrs map[string][]map[string]interface{}) map[string]interface{} {
+	metadata := map[string]string{}
+	if tag != "" {
+		metadata["vald-agent"] = tag
+	}
+	return map[string]interface{}{
+		"id":        status + tag,
+		"status":    status,
+		"metadata":  metadata,
+		"addresses": addrs,
+	}
+}
+
+func addr(ip string, version int, typ string) map[string]interface{} {
+	return map[string]interface{}{
+		"addr":            ip,
+		"version":         version,
+		"OS-EXT-IPS:type": typ,
+	}
+}
+
+func agents(res *payload.Info_Agents) map[string]string {
+	m := make(map[string]string)
+	for _, a := range res.GetAgents() {
+		m[a.GetIp()] = a.GetState()
+	}
+	return m
+}
+
+func TestList(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	f := newFakeOpenStack(t)
+	defer f.srv.Close()
+	f.servers = []map[string]interface{}{
+		novaFixture("ACTIVE", "ngt", map[string][]map[string]interface{}{
+			"private": {addr("fd00::1", 6, "fixed"), addr("203.0.113.1", 4, "floating"), addr("10.0.0.1", 4, "fixed"), addr("10.0.0.11", 4, "fixed")},
+		}),
+		novaFixture("ACTIVE", "other", map[string][]map[string]interface{}{
+			"private": {addr("10.0.0.2", 4, "fixed")},
+		}),
+		novaFixture("ACTIVE", "", map[string][]map[string]interface{}{
+			"private": {addr("10.0.0.3", 4, "fixed")},
+		}),
+		novaFixture("BUILD", "ngt", map[string][]map[string]interface{}{
+			"private": {addr("10.0.0.4", 4, "fixed")},
+			"storage": {addr("192.168.0.4", 4, "fixed")},
+		}),
+	}
+
+	d, err := NewDiscoverer(
+		WithDiscovererAuthURL(f.srv.URL+"/identity/v3"),
+		WithDiscovererUsername("vald"),
+		WithDiscovererPassword("secret"),
+		WithDiscovererProjectName("vald"),
+		WithDiscovererRegion("RegionOne"),
+		WithDiscovererMetadataKey("vald-agent"),
+		WithDiscovererMetadataValue("ngt"),
+		WithDiscovererNetwork("private"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestList(%v)", err)
+	}
+	defer d.Close()
+
+	if err := d.(*discoverer).list(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: TestList(%v)", err)
+	}
+	res, _ := d.Discover(context.Background())
+	wants := map[string]string{
+		"10.0.0.1": AgentReady,
+		"10.0.0.4": "BUILD",
+	}
+	if got := agents(res); !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestList: %v, wanted: %v", got, wants)
+	}
+
+	f.mu.Lock()
+	f.revoked = true
+	f.mu.Unlock()
+	if err := d.(*discoverer).list(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: TestList(%v)", err)
+	}
+	f.mu.Lock()
+	tokens := f.tokens
+	f.mu.Unlock()
+	if tokens != 2 {
+		t.Errorf("TestList: %d tokens are issued, wanted re-authentication after the token is revoked", tokens)
+	}
+
+	f.srv.Close()
+	if err := d.(*discoverer).list(context.Background()); err == nil {
+		t.Errorf("TestList: wanted the listing error")
+	}
+	res, _ = d.Discover(context.Background())
+	if got := agents(res); !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestList: %v, wanted the previous agents %v", got, wants)
+	}
+}
+
+func TestListOneAddressPerServer(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	f := newFakeOpenStack(t)
+	defer f.srv.Close()
+	f.servers = []map[string]interface{}{
+		novaFixture("ACTIVE", "ngt", map[string][]map[string]interface{}{
+			"storage": {addr("192.168.0.1", 4, "fixed")},
+			"private": {addr("10.0.0.1", 4, "fixed"), addr("10.0.0.11", 4, "fixed")},
+		}),
+		novaFixture("BUILD", "ngt", map[string][]map[string]interface{}{
+			"storage": {addr("192.168.0.2", 4, "fixed")},
+		}),
+	}
+
+	d, err := NewDiscoverer(
+		WithDiscovererAuthURL(f.srv.URL+"/identity/v3"),
+		WithDiscovererUsername("vald"),
+		WithDiscovererPassword("secret"),
+		WithDiscovererProjectName("vald"),
+		WithDiscovererRegion("RegionOne"),
+		WithDiscovererMetadataKey("vald-agent"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestListOneAddressPerServer(%v)", err)
+	}
+	defer d.Close()
+
+	if err := d.(*discoverer).list(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: TestListOneAddressPerServer(%v)", err)
+	}
+	res, _ := d.Discover(context.Background())
+	wants := map[string]string{
+		"10.0.0.1":    AgentReady,
+		"192.168.0.2": "BUILD",
+	}
+	if got := agents(res); !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestListOneAddressPerServer: %v, wanted: %v", got, wants)
+	}
+}
+
+func TestAuthenticateFailed(t *testing.T) {
+	f := newFakeOpenStack(t)
+	defer f.srv.Close()
+
+	d, err := NewDiscoverer(
+		WithDiscovererAuthURL(f.srv.URL+"/identity/v3"),
+		WithDiscovererUsername("vald"),
+		WithDiscovererPassword("wrong"),
+		WithDiscovererProjectName("vald"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestAuthenticateFailed(%v)", err)
+	}
+	if err := d.(*discoverer).list(context.Background()); err == nil {
+		t.Errorf("TestAuthenticateFailed: wanted the authentication error")
+	}
+}
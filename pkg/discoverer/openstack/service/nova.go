@@ -0,0 +1,259 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+const (
+	novaServerActive = "ACTIVE"
+	novaAddressFixed = "fixed"
+
+	// tokenExpirationMargin is the margin of re-authenticating before the token expires.
+	tokenExpirationMargin = time.Minute
+)
+
+type novaServer struct {
+	ID        string                   `json:"id"`
+	Name      string                   `json:"name"`
+	Status    string                   `json:"status"`
+	Metadata  map[string]string        `json:"metadata"`
+	Addresses map[string][]novaAddress `json:"addresses"`
+}
+
+type novaAddress struct {
+	Addr    string `json:"addr"`
+	Version int    `json:"version"`
+	Type    string `json:"OS-EXT-IPS:type"`
+}
+
+type link struct {
+	Rel  string `json:"rel"`
+	Href string `json:"href"`
+}
+
+type novaServersResponse struct {
+	Servers []novaServer `json:"servers"`
+	Links   []link       `json:"servers_links"`
+}
+
+type name struct {
+	Name string `json:"name"`
+}
+
+type project struct {
+	Name   string `json:"name"`
+	Domain name   `json:"domain"`
+}
+
+type authRequest struct {
+	Auth struct {
+		Identity struct {
+			Methods  []string `json:"methods"`
+			Password struct {
+				User struct {
+					Name     string `json:"name"`
+					Domain   name   `json:"domain"`
+					Password string `json:"password"`
+				} `json:"user"`
+			} `json:"password"`
+		} `json:"identity"`
+		Scope *struct {
+			Project project `json:"project"`
+		} `json:"scope,omitempty"`
+	} `json:"auth"`
+}
+
+type authResponse struct {
+	Token struct {
+		ExpiresAt time.Time `json:"expires_at"`
+		Catalog   []struct {
+			Type      string `json:"type"`
+			Endpoints []struct {
+				Interface string `json:"interface"`
+				Region    string `json:"region"`
+				URL       string `json:"url"`
+			} `json:"endpoints"`
+		} `json:"catalog"`
+	} `json:"token"`
+}
+
+// servers lists all the servers of the project following the pagination links.
+func (d *discoverer) servers(ctx context.Context) ([]novaServer, error) {
+	var srvs []novaServer
+	url := ""
+	for {
+		var res novaServersResponse
+		err := d.authorized(ctx, func(token, computeURL string) error {
+			if url == "" {
+				url = strings.TrimSuffix(computeURL, "/") + "/servers/detail"
+			}
+			return d.do(ctx, http.MethodGet, url, token, nil, &res)
+		})
+		if err != nil {
+			return nil, err
+		}
+		srvs = append(srvs, res.Servers...)
+
+		url = ""
+		for _, l := range res.Links {
+			if l.Rel == "next" {
+				url = l.Href
+			}
+		}
+		if url == "" || len(res.Servers) == 0 {
+			return srvs, nil
+		}
+	}
+}
+
+// authorized calls f with the cached token, and re-authenticates and retries once when the token is rejected.
+func (d *discoverer) authorized(ctx context.Context, f func(token, computeURL string) error) error {
+	token, computeURL, err := d.authenticate(ctx, false)
+	if err != nil {
+		return err
+	}
+	err = f(token, computeURL)
+	if err != errors.ErrOpenStackUnauthorized {
+		return err
+	}
+	token, computeURL, err = d.authenticate(ctx, true)
+	if err != nil {
+		return err
+	}
+	return f(token, computeURL)
+}
+
+// authenticate issues a project scoped token by the password and finds the compute endpoint in its service catalog.
+func (d *discoverer) authenticate(ctx context.Context, force bool) (token, computeURL string, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !force && d.token != "" && time.Now().Add(tokenExpirationMargin).Before(d.expiresAt) {
+		return d.token, d.computeURL, nil
+	}
+
+	var req authRequest
+	req.Auth.Identity.Methods = []string{"password"}
+	req.Auth.Identity.Password.User.Name = d.username
+	req.Auth.Identity.Password.User.Domain.Name = d.userDomainName
+	req.Auth.Identity.Password.User.Password = d.password
+	if d.projectName != "" {
+		req.Auth.Scope = &struct {
+			Project project `json:"project"`
+		}{
+			Project: project{
+				Name:   d.projectName,
+				Domain: name{Name: d.projectDomainName},
+			},
+		}
+	}
+
+	var res authResponse
+	url := strings.TrimSuffix(d.authURL, "/") + "/auth/tokens"
+	header, err := d.request(ctx, http.MethodPost, url, "", req, &res)
+	if err != nil {
+		return "", "", errors.ErrOpenStackAuthFailed(err)
+	}
+
+	computeURL = ""
+	for _, svc := range res.Token.Catalog {
+		if svc.Type != "compute" {
+			continue
+		}
+		for _, ep := range svc.Endpoints {
+			if ep.Interface == d.endpointInterface && (d.region == "" || ep.Region == d.region) {
+				computeURL = ep.URL
+				break
+			}
+		}
+	}
+	if computeURL == "" {
+		return "", "", errors.ErrOpenStackComputeEndpointNotFound(d.region)
+	}
+
+	d.token = header.Get("X-Subject-Token")
+	d.expiresAt = res.Token.ExpiresAt
+	d.computeURL = computeURL
+	return d.token, d.computeURL, nil
+}
+
+func (d *discoverer) do(ctx context.Context, method, url, token string, body, res interface{}) error {
+	_, err := d.request(ctx, method, url, token, body, res)
+	return err
+}
+
+func (d *discoverer) request(ctx context.Context, method, url, token string, body, res interface{}) (http.Header, error) {
+	var r io.Reader
+	if body != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+		r = buf
+	}
+	req, err := http.NewRequest(method, url, r)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Auth-Token", token)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized && token != "":
+		return nil, errors.ErrOpenStackUnauthorized
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		return nil, errors.ErrOpenStackUnexpectedStatus(url, resp.StatusCode)
+	}
+	if res != nil {
+		if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+			return nil, err
+		}
+	}
+	return resp.Header, nil
+}
@@ -0,0 +1,142 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type DiscovererOption func(*discoverer)
+
+var (
+	defaultDiscovererOpts = []DiscovererOption{
+		WithDiscovererUserDomainName("Default"),
+		WithDiscovererProjectDomainName("Default"),
+		WithDiscovererEndpointInterface("public"),
+		WithDiscovererRefreshDuration("10s"),
+		WithDiscovererHTTPClient(&http.Client{
+			Timeout: 10 * time.Second,
+		}),
+	}
+)
+
+func WithDiscovererAuthURL(url string) DiscovererOption {
+	return func(d *discoverer) {
+		d.authURL = url
+	}
+}
+
+func WithDiscovererUsername(name string) DiscovererOption {
+	return func(d *discoverer) {
+		d.username = name
+	}
+}
+
+func WithDiscovererPassword(pass string) DiscovererOption {
+	return func(d *discoverer) {
+		d.password = pass
+	}
+}
+
+func WithDiscovererUserDomainName(name string) DiscovererOption {
+	return func(d *discoverer) {
+		if name == "" {
+			return
+		}
+		d.userDomainName = name
+	}
+}
+
+func WithDiscovererProjectName(name string) DiscovererOption {
+	return func(d *discoverer) {
+		d.projectName = name
+	}
+}
+
+func WithDiscovererProjectDomainName(name string) DiscovererOption {
+	return func(d *discoverer) {
+		if name == "" {
+			return
+		}
+		d.projectDomainName = name
+	}
+}
+
+func WithDiscovererRegion(region string) DiscovererOption {
+	return func(d *discoverer) {
+		d.region = region
+	}
+}
+
+func WithDiscovererEndpointInterface(iface string) DiscovererOption {
+	return func(d *discoverer) {
+		if iface == "" {
+			return
+		}
+		d.endpointInterface = iface
+	}
+}
+
+func WithDiscovererMetadataKey(key string) DiscovererOption {
+	return func(d *discoverer) {
+		d.metadataKey = key
+	}
+}
+
+func WithDiscovererMetadataValue(val string) DiscovererOption {
+	return func(d *discoverer) {
+		d.metadataValue = val
+	}
+}
+
+func WithDiscovererNetwork(network string) DiscovererOption {
+	return func(d *discoverer) {
+		d.network = network
+	}
+}
+
+func WithDiscovererRefreshDuration(dur string) DiscovererOption {
+	return func(d *discoverer) {
+		if dur == "" {
+			return
+		}
+		p, err := timeutil.Parse(dur)
+		if err != nil {
+			p = 10 * time.Second
+		}
+		d.refreshDuration = p
+	}
+}
+
+func WithDiscovererHTTPClient(c *http.Client) DiscovererOption {
+	return func(d *discoverer) {
+		if c == nil {
+			return
+		}
+		d.client = c
+	}
+}
@@ -2,27 +2,134 @@ package usecase
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/net/tcp"
+	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/timeutil"
 	"github.com/vdaas/vald/pkg/discoverer/openstack/config"
+	"github.com/vdaas/vald/pkg/discoverer/openstack/handler/grpc"
+	"github.com/vdaas/vald/pkg/discoverer/openstack/handler/rest"
+	"github.com/vdaas/vald/pkg/discoverer/openstack/router"
 	"github.com/vdaas/vald/pkg/discoverer/openstack/service"
 )
 
-type Runner interface {
-	Start(ctx context.Context) chan []error
-}
+type Runner runner.Runner
 
 type run struct {
-	cfg    config.Data
-	server service.Server
+	cfg        *config.Data
+	server     service.Server
+	discoverer service.Discoverer
 }
 
-func New(cfg config.Data) (Runner, error) {
+func New(cfg *config.Data) (Runner, error) {
+	timeout, err := timeutil.Parse(cfg.OpenStack.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	dsc, err := service.NewDiscoverer(
+		service.WithDiscovererAuthURL(cfg.OpenStack.AuthURL),
+		service.WithDiscovererUsername(cfg.OpenStack.Username),
+		service.WithDiscovererPassword(cfg.OpenStack.Password),
+		service.WithDiscovererUserDomainName(cfg.OpenStack.UserDomainName),
+		service.WithDiscovererProjectName(cfg.OpenStack.ProjectName),
+		service.WithDiscovererProjectDomainName(cfg.OpenStack.ProjectDomainName),
+		service.WithDiscovererRegion(cfg.OpenStack.Region),
+		service.WithDiscovererEndpointInterface(cfg.OpenStack.EndpointInterface),
+		service.WithDiscovererMetadataKey(cfg.OpenStack.MetadataKey),
+		service.WithDiscovererMetadataValue(cfg.OpenStack.MetadataValue),
+		service.WithDiscovererNetwork(cfg.OpenStack.Network),
+		service.WithDiscovererRefreshDuration(cfg.OpenStack.RefreshDuration),
+		service.WithDiscovererHTTPClient(&http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				DialContext: tcp.NewDialer(context.Background(),
+					tcp.WithDialerTimeout(cfg.OpenStack.TCP.Dialer.Timeout),
+					tcp.WithDialerKeepAlive(cfg.OpenStack.TCP.Dialer.KeepAlive),
+				),
+			},
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	g := grpc.New(grpc.WithDiscoverer(dsc))
+
+	srv, err := service.NewServer(
+		service.WithConfig(cfg.Server),
+		service.WithREST(
+			router.New(
+				router.WithHandler(
+					rest.New(
+						rest.WithDiscoverer(g),
+					),
+				),
+			),
+		),
+		service.WithGRPC(g),
+		// TODO add GraphQL handler
+	)
+
+	if err != nil {
+		dsc.Close()
+		return nil, err
+	}
+
 	return &run{
-		cfg:    cfg,
-		server: service.NewServer(nil),
+		cfg:        cfg,
+		server:     srv,
+		discoverer: dsc,
 	}, nil
 }
 
-func (t *run) Start(ctx context.Context) chan error {
-	return t.server.ListenAndServe(ctx)
+func (r *run) PreStart() error {
+	return nil
+}
+
+func (r *run) Start(ctx context.Context) <-chan error {
+	ech := make(chan error)
+	sech := r.server.ListenAndServe(ctx)
+	dech := r.discoverer.Start(ctx)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		var ok bool
+		for sech != nil || dech != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err, ok = <-sech:
+				if !ok {
+					sech = nil
+				}
+			case err, ok = <-dech:
+				if !ok {
+					dech = nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		return nil
+	}))
+	return ech
+}
+
+func (r *run) PreStop() error {
+	return nil
+}
+
+func (r *run) Stop(ctx context.Context) error {
+	err := r.server.Shutdown(ctx)
+	if cerr := r.discoverer.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
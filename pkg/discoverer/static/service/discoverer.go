@@ -0,0 +1,136 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// Discoverer serves the agents listed in the configuration.
+type Discoverer interface {
+	Start(ctx context.Context) <-chan error
+	Discover(ctx context.Context) (*payload.Info_Agents, error)
+	// Watch calls f with the snapshot of the agents and then with every membership change until the context is canceled.
+	Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error
+	Close() error
+}
+
+// AgentReady is the state of the listed agents, which are considered ready to serve.
+const AgentReady = membership.ReadyState
+
+type discoverer struct {
+	agents         []string
+	reload         func() ([]string, error)
+	reloadDuration time.Duration
+	members        membership.Members
+}
+
+func NewDiscoverer(opts ...DiscovererOption) (Discoverer, error) {
+	d := new(discoverer)
+	for _, opt := range append(defaultDiscovererOpts, opts...) {
+		opt(d)
+	}
+	d.members = membership.New()
+
+	err := d.set(d.agents)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Start re-reads the agents every reload duration, the invalid list is reported and the previous one is kept.
+func (d *discoverer) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	if d.reload == nil {
+		close(ech)
+		return ech
+	}
+
+	errgroup.Go(safety.RecoverFunc(func() error {
+		defer close(ech)
+
+		t := time.NewTicker(d.reloadDuration)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+
+			agents, err := d.reload()
+			if err == nil {
+				err = d.set(agents)
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+	}))
+	return ech
+}
+
+func (d *discoverer) set(ips []string) error {
+	agents := make([]*payload.Info_Agent, 0, len(ips))
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			return errors.ErrInvalidAgentIP(ip)
+		}
+		agents = append(agents, &payload.Info_Agent{
+			Ip:    ip,
+			State: AgentReady,
+		})
+	}
+	d.members.Set(agents)
+	log.Debugf("static discoverer serves %d agents", len(agents))
+	return nil
+}
+
+func (d *discoverer) Discover(ctx context.Context) (*payload.Info_Agents, error) {
+	return &payload.Info_Agents{
+		Agents: d.members.Agents(),
+	}, nil
+}
+
+func (d *discoverer) Watch(ctx context.Context, f func(*payload.Info_AgentEvent) error) error {
+	return d.members.Watch(ctx, f)
+}
+
+func (d *discoverer) Close() error {
+	return nil
+}
@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/log"
+)
+
+func ips(agents *payload.Info_Agents) []string {
+	var res []string
+	for _, a := range agents.GetAgents() {
+		res = append(res, a.GetIp())
+	}
+	return res
+}
+
+func TestDiscoverReloadsAgents(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	var (
+		mu     sync.Mutex
+		agents = []string{"10.0.0.2", "10.0.0.1"}
+	)
+	d, err := NewDiscoverer(
+		WithDiscovererAgents(agents...),
+		WithDiscovererReloadDuration("10ms"),
+		WithDiscovererReloadFunc(func() ([]string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return agents, nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestDiscoverReloadsAgents(%v)", err)
+	}
+
+	res, _ := d.Discover(context.Background())
+	if wants := []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(ips(res), wants) {
+		t.Errorf("TestDiscoverReloadsAgents: %v, wanted: %v", ips(res), wants)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errgroup.Init(ctx)
+	ech := d.Start(ctx)
+
+	mu.Lock()
+	agents = []string{"10.0.0.3"}
+	mu.Unlock()
+	wants := []string{"10.0.0.3"}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		res, _ = d.Discover(context.Background())
+		if reflect.DeepEqual(ips(res), wants) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("TestDiscoverReloadsAgents: %v, wanted: %v", ips(res), wants)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	mu.Lock()
+	agents = []string{"10.0.0.4", "not an ip"}
+	mu.Unlock()
+	select {
+	case err := <-ech:
+		if err == nil {
+			t.Errorf("TestDiscoverReloadsAgents: wanted the invalid ip error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestDiscoverReloadsAgents: no invalid ip error")
+	}
+	res, _ = d.Discover(context.Background())
+	if !reflect.DeepEqual(ips(res), wants) {
+		t.Errorf("TestDiscoverReloadsAgents: %v, wanted the previous agents %v", ips(res), wants)
+	}
+}
+
+func TestNewDiscovererInvalidAgent(t *testing.T) {
+	if _, err := NewDiscoverer(WithDiscovererAgents("10.0.0.1", "agent-0")); err == nil {
+		t.Errorf("TestNewDiscovererInvalidAgent: wanted an error")
+	}
+}
@@ -0,0 +1,64 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+type DiscovererOption func(*discoverer)
+
+var (
+	defaultDiscovererOpts = []DiscovererOption{
+		WithDiscovererReloadDuration("10s"),
+	}
+)
+
+func WithDiscovererAgents(ips ...string) DiscovererOption {
+	return func(d *discoverer) {
+		d.agents = ips
+	}
+}
+
+// WithDiscovererReloadFunc sets the function re-reading the agent ips on every reload duration.
+func WithDiscovererReloadFunc(f func() ([]string, error)) DiscovererOption {
+	return func(d *discoverer) {
+		d.reload = f
+	}
+}
+
+func WithDiscovererReloadDuration(dur string) DiscovererOption {
+	return func(d *discoverer) {
+		if dur == "" {
+			return
+		}
+		p, err := timeutil.Parse(dur)
+		if err != nil {
+			p = 10 * time.Second
+		}
+		d.reloadDuration = p
+	}
+}
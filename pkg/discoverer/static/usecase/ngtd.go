@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/pkg/discoverer/static/config"
+	"github.com/vdaas/vald/pkg/discoverer/static/handler/grpc"
+	"github.com/vdaas/vald/pkg/discoverer/static/handler/rest"
+	"github.com/vdaas/vald/pkg/discoverer/static/router"
+	"github.com/vdaas/vald/pkg/discoverer/static/service"
+)
+
+type Runner runner.Runner
+
+type run struct {
+	cfg        *config.Data
+	server     service.Server
+	discoverer service.Discoverer
+}
+
+func New(cfg *config.Data) (Runner, error) {
+	dsc, err := service.NewDiscoverer(
+		service.WithDiscovererAgents(cfg.Static.Agents...),
+		service.WithDiscovererReloadDuration(cfg.Static.ReloadDuration),
+		service.WithDiscovererReloadFunc(func() ([]string, error) {
+			c, err := cfg.Reload()
+			if err != nil {
+				return nil, err
+			}
+			return c.Static.Agents, nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	g := grpc.New(grpc.WithDiscoverer(dsc))
+
+	srv, err := service.NewServer(
+		service.WithConfig(cfg.Server),
+		service.WithREST(
+			router.New(
+				router.WithHandler(
+					rest.New(
+						rest.WithDiscoverer(g),
+					),
+				),
+			),
+		),
+		service.WithGRPC(g),
+		// TODO add GraphQL handler
+	)
+
+	if err != nil {
+		dsc.Close()
+		return nil, err
+	}
+
+	return &run{
+		cfg:        cfg,
+		server:     srv,
+		discoverer: dsc,
+	}, nil
+}
+
+func (r *run) PreStart() error {
+	return nil
+}
+
+func (r *run) Start(ctx context.Context) <-chan error {
+	ech := make(chan error)
+	sech := r.server.ListenAndServe(ctx)
+	dech := r.discoverer.Start(ctx)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		var ok bool
+		for sech != nil || dech != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err, ok = <-sech:
+				if !ok {
+					sech = nil
+				}
+			case err, ok = <-dech:
+				if !ok {
+					dech = nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		return nil
+	}))
+	return ech
+}
+
+func (r *run) PreStop() error {
+	return nil
+}
+
+func (r *run) Stop(ctx context.Context) error {
+	err := r.server.Shutdown(ctx)
+	if cerr := r.discoverer.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -36,8 +36,8 @@ type Data struct {
 	// Server represent all server configurations
 	Server *config.Servers `json:"server_config" yaml:"server_config"`
 
-	// NGT represent ngt core configuration
-	NGT *config.NGT `json:"ngt" yaml:"ngt"`
+	// Backup represent the backup manager configuration
+	Backup *config.Backup `json:"backup" yaml:"backup"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -50,8 +50,10 @@ func NewConfig(path string) (cfg *Data, err error) {
 	if cfg.Server != nil {
 		cfg.Server = cfg.Server.Bind()
 	}
-	if cfg.NGT != nil {
-		cfg.NGT = cfg.NGT.Bind()
+	if cfg.Backup != nil {
+		cfg.Backup = cfg.Backup.Bind()
+	} else {
+		cfg.Backup = new(config.Backup).Bind()
 	}
 
 	return cfg, nil
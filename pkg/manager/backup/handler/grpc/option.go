@@ -31,8 +31,8 @@ var (
 	defaultOpts = []Option{}
 )
 
-func WithNGT(n service.NGT) Option {
+func WithBackup(b service.Backup) Option {
 	return func(s *server) {
-		s.ngt = n
+		s.backup = b
 	}
 }
@@ -57,97 +57,29 @@ func New(opts ...Option) http.Handler {
 				h.Index,
 			},
 			{
-				"Search",
+				"Backup",
 				[]string{
 					http.MethodPost,
 				},
-				"/search",
-				h.Search,
+				"/backup",
+				h.Backup,
 			},
 			{
-				"Search By ID",
+				"ListBackups",
 				[]string{
 					http.MethodGet,
-				},
-				"/search/{id}",
-				h.SearchByID,
-			},
-			{
-				"Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert",
-				h.Insert,
-			},
-			{
-				"Multiple Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert/multi",
-				h.MultiInsert,
-			},
-			{
-				"Update",
-				[]string{
-					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
-				},
-				"/update",
-				h.Update,
-			},
-			{
-				"Multiple Update",
-				[]string{
 					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
 				},
-				"/update/multi",
-				h.MultiUpdate,
+				"/backup/list",
+				h.ListBackups,
 			},
 			{
-				"Remove",
+				"Restore",
 				[]string{
-					http.MethodDelete,
-				},
-				"/delete/{id}",
-				h.Remove,
-			},
-			{
-				"Multiple Remove",
-				[]string{
-					http.MethodDelete,
 					http.MethodPost,
 				},
-				"/delete/multi",
-				h.MultiRemove,
-			},
-			{
-				"Create Index",
-				[]string{
-					http.MethodGet,
-				},
-				"/index/create/{pool}",
-				h.CreateIndex,
-			},
-			{
-				"Save Index",
-				[]string{
-					http.MethodGet,
-				},
-				"/index/save",
-				h.SaveIndex,
-			},
-			{
-				"GetObject",
-				[]string{
-					http.MethodGet,
-				},
-				"/object/{id}",
-				h.GetObject,
+				"/restore",
+				h.Restore,
 			},
 		}...),
 		routing.WithTimeout(r.timeout))
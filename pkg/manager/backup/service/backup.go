@@ -0,0 +1,505 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	"github.com/vdaas/vald/internal/safety"
+	"google.golang.org/grpc"
+)
+
+// Backup stores the index snapshots of the agents in the blob storage.
+type Backup interface {
+	// Start backs up all the agents every backup duration, and applies the retention policy after each backup.
+	Start(ctx context.Context) <-chan error
+	// Backup backs up the index of the agent, or of every agent listed by the discoverer when the agent is empty.
+	Backup(ctx context.Context, agent, index string) ([]*payload.Backup_Info, error)
+	// List returns the backups of the agent and the index newest first, the empty agent and index match any.
+	List(ctx context.Context, agent, index string) ([]*payload.Backup_Info, error)
+	// Restore verifies the backup and restores it to the agent, or to the agent it was taken from when the agent is empty.
+	Restore(ctx context.Context, id, agent string) error
+	Close() error
+}
+
+type backup struct {
+	bucket         blob.Bucket
+	dialOpts       []grpc.DialOption
+	discovererAddr string
+	discoverer     *grpc.ClientConn
+	agentPort      int
+	duration       time.Duration
+	maxBackups     int
+	maxAge         time.Duration
+}
+
+const (
+	// restoreChunkSize is the size of the data in each Restore chunk
+	restoreChunkSize = 1 << 20
+
+	snapshotFile = "snapshot"
+	infoFile     = "info.json"
+
+	// defaultIndexKey is the key segment of the default index, which has the empty name.
+	defaultIndexKey = "-"
+)
+
+func NewBackup(opts ...BackupOption) (Backup, error) {
+	b := new(backup)
+	for _, opt := range append(defaultBackupOpts, opts...) {
+		opt(b)
+	}
+	if b.bucket == nil {
+		return nil, errors.ErrInvalidBlobStorage("")
+	}
+
+	if b.discovererAddr != "" {
+		conn, err := grpc.Dial(b.discovererAddr, b.dialOpts...)
+		if err != nil {
+			return nil, errors.ErrGRPCDialFailed(err, b.discovererAddr)
+		}
+		b.discoverer = conn
+	}
+	return b, nil
+}
+
+func (b *backup) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	if b.duration <= 0 {
+		close(ech)
+		return ech
+	}
+
+	errgroup.Go(safety.RecoverFunc(func() error {
+		defer close(ech)
+
+		t := time.NewTicker(b.duration)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+			infos, err := b.Backup(ctx, "", "")
+			if err != nil && ctx.Err() == nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+			log.Infof("backup stored %d snapshots", len(infos))
+		}
+	}))
+	return ech
+}
+
+func (b *backup) Backup(ctx context.Context, addr, index string) ([]*payload.Backup_Info, error) {
+	addrs := []string{addr}
+	if addr == "" {
+		var err error
+		addrs, err = b.agents(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		mu    sync.Mutex
+		infos = make([]*payload.Backup_Info, 0, len(addrs))
+		errs  error
+		_, eg = errgroup.New(ctx)
+	)
+	for _, addr := range addrs {
+		addr := addr
+		eg.Go(safety.RecoverFunc(func() error {
+			info, err := b.backup(ctx, addr, index)
+			if err == nil {
+				err = b.retain(ctx, addr, index)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if info != nil {
+				infos = append(infos, info)
+			}
+			if err != nil {
+				errs = errors.Wrap(errs, errors.ErrAgentRequestFailed(err, addr).Error())
+			}
+			return nil
+		}))
+	}
+	eg.Wait()
+	if errs != nil {
+		return nil, errs
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].GetId() < infos[j].GetId()
+	})
+	return infos, nil
+}
+
+// agents returns the gRPC addresses of the ready agents listed by the discoverer.
+func (b *backup) agents(ctx context.Context) ([]string, error) {
+	if b.discoverer == nil {
+		return nil, errors.ErrBackupAgentsNotFound
+	}
+	addrs, err := membership.Discover(ctx, b.discoverer, b.agentPort)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, errors.ErrBackupAgentsNotFound
+	}
+	return addrs, nil
+}
+
+// backup streams the snapshot of the agent into the bucket, and writes its info once the snapshot is stored.
+func (b *backup) backup(ctx context.Context, addr, index string) (info *payload.Backup_Info, err error) {
+	conn, err := grpc.DialContext(ctx, addr, b.dialOpts...)
+	if err != nil {
+		return nil, errors.ErrGRPCDialFailed(err, addr)
+	}
+	defer conn.Close()
+
+	stream, err := agent.NewAgentClient(conn).Snapshot(ctx, &payload.Controll_IndexRequest{
+		Index: index,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	info = &payload.Backup_Info{
+		Id:        path.Join(prefix(addr, index), fmt.Sprintf("%019d", now.UnixNano())),
+		Agent:     addr,
+		Index:     index,
+		Timestamp: now.UnixNano(),
+	}
+	snapshot := path.Join(info.GetId(), snapshotFile)
+
+	w, err := b.bucket.Writer(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if derr := b.bucket.Delete(ctx, snapshot); derr != nil {
+				log.Warnf("failed to delete the incomplete snapshot %s: %v", snapshot, derr)
+			}
+		}
+	}()
+
+	cw := newChecksumWriter(w)
+	for {
+		c, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			w.Close()
+			return nil, err
+		}
+		if _, err := cw.Write(c.GetData()); err != nil {
+			w.Close()
+			return nil, err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	info.Size_, info.Checksum = cw.size, cw.checksum()
+
+	if err = b.writeInfo(ctx, info); err != nil {
+		return nil, err
+	}
+	log.Infof("backup %s stored %d bytes of agent %s", info.GetId(), info.GetSize_(), addr)
+	return info, nil
+}
+
+// retain deletes the backups of the agent index exceeding the max backups or the max age, keeping the newest one.
+func (b *backup) retain(ctx context.Context, addr, index string) error {
+	if b.maxBackups <= 0 && b.maxAge <= 0 {
+		return nil
+	}
+	ids, err := b.ids(ctx, prefix(addr, index)+"/")
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	// ids are sorted oldest first by their zero padded timestamp.
+	deadline := time.Now().Add(-b.maxAge).UnixNano()
+	for i, id := range ids[:len(ids)-1] {
+		expired := b.maxBackups > 0 && len(ids)-i > b.maxBackups
+		if !expired && b.maxAge > 0 {
+			ts, err := strconv.ParseInt(path.Base(id), 10, 64)
+			expired = err == nil && ts < deadline
+		}
+		if !expired {
+			continue
+		}
+		if err := b.delete(ctx, id); err != nil {
+			return err
+		}
+		log.Infof("backup %s deleted by the retention policy", id)
+	}
+	return nil
+}
+
+// delete removes the info first so that the partially deleted backup is not listed.
+func (b *backup) delete(ctx context.Context, id string) error {
+	err := b.bucket.Delete(ctx, path.Join(id, infoFile))
+	if err != nil {
+		return err
+	}
+	return b.bucket.Delete(ctx, path.Join(id, snapshotFile))
+}
+
+// ids returns the ids of the complete backups with the key prefix in lexical order.
+func (b *backup) ids(ctx context.Context, prefix string) ([]string, error) {
+	keys, err := b.bucket.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys)/2)
+	for _, key := range keys {
+		if path.Base(key) == infoFile {
+			ids = append(ids, path.Dir(key))
+		}
+	}
+	return ids, nil
+}
+
+func (b *backup) List(ctx context.Context, addr, index string) ([]*payload.Backup_Info, error) {
+	p := ""
+	if addr != "" {
+		p = segment(addr) + "/"
+	}
+	ids, err := b.ids(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*payload.Backup_Info, 0, len(ids))
+	for _, id := range ids {
+		info, err := b.readInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if index != "" && info.GetIndex() != index {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].GetTimestamp() > infos[j].GetTimestamp()
+	})
+	return infos, nil
+}
+
+func (b *backup) Restore(ctx context.Context, id, addr string) error {
+	info, err := b.readInfo(ctx, id)
+	if err != nil {
+		return err
+	}
+	if addr == "" {
+		addr = info.GetAgent()
+	}
+
+	f, err := b.download(ctx, info)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	conn, err := grpc.DialContext(ctx, addr, b.dialOpts...)
+	if err != nil {
+		return errors.ErrGRPCDialFailed(err, addr)
+	}
+	defer conn.Close()
+
+	stream, err := agent.NewAgentClient(conn).Restore(ctx)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, restoreChunkSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 {
+			serr := stream.Send(&payload.Snapshot_Chunk{
+				Data:  buf[:n],
+				Index: info.GetIndex(),
+			})
+			if serr != nil {
+				return serr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	log.Infof("backup %s restored to agent %s", id, addr)
+	return nil
+}
+
+// download copies the snapshot of the backup into a temporary file and verifies its size and checksum,
+// so that the corrupted snapshot is never sent to the agent.
+func (b *backup) download(ctx context.Context, info *payload.Backup_Info) (*os.File, error) {
+	r, err := b.bucket.Reader(ctx, path.Join(info.GetId(), snapshotFile))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	f, err := ioutil.TempFile("", "vald-backup-")
+	if err != nil {
+		return nil, err
+	}
+	cw := newChecksumWriter(f)
+	_, err = io.Copy(cw, r)
+	if err == nil && (cw.size != info.GetSize_() || cw.checksum() != info.GetChecksum()) {
+		err = errors.ErrBackupChecksumMismatch(info.GetId())
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return f, nil
+}
+
+func (b *backup) readInfo(ctx context.Context, id string) (*payload.Backup_Info, error) {
+	key := path.Join(id, infoFile)
+	r, err := b.bucket.Reader(ctx, key)
+	if err != nil {
+		if errors.IsBlobNotFound(err) {
+			return nil, errors.ErrBackupNotFound(id)
+		}
+		return nil, err
+	}
+	defer r.Close()
+
+	info := new(payload.Backup_Info)
+	err = json.NewDecoder(r).Decode(info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (b *backup) writeInfo(ctx context.Context, info *payload.Backup_Info) error {
+	w, err := b.bucket.Writer(ctx, path.Join(info.GetId(), infoFile))
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(info)
+	if err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (b *backup) Close() error {
+	var err error
+	if b.discoverer != nil {
+		err = b.discoverer.Close()
+	}
+	if cerr := b.bucket.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// prefix returns the key prefix of the backups of the agent index.
+func prefix(addr, index string) string {
+	if index == "" {
+		return path.Join(segment(addr), defaultIndexKey)
+	}
+	return path.Join(segment(addr), segment(index))
+}
+
+// segment escapes the slashes of the key segment.
+func segment(s string) string {
+	return url.PathEscape(s)
+}
+
+// checksumWriter counts and hashes the written data.
+type checksumWriter struct {
+	w    io.Writer
+	h    hash.Hash
+	size int64
+}
+
+func newChecksumWriter(w io.Writer) *checksumWriter {
+	return &checksumWriter{
+		w: w,
+		h: sha256.New(),
+	}
+}
+
+func (c *checksumWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.h.Write(p[:n])
+	c.size += int64(n)
+	return n, err
+}
+
+func (c *checksumWriter) checksum() string {
+	return hex.EncodeToString(c.h.Sum(nil))
+}
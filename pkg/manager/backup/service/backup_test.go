@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/db/storage/blob/fs"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc/grpctest"
+)
+
+type fakeAgent struct {
+	agent.UnimplementedAgentServer
+	snapshot []byte
+
+	mu       sync.Mutex
+	restored []byte
+	index    string
+}
+
+func (f *fakeAgent) Snapshot(req *payload.Controll_IndexRequest, stream agent.Agent_SnapshotServer) error {
+	for i := 0; i < len(f.snapshot); i += 3 {
+		end := i + 3
+		if end > len(f.snapshot) {
+			end = len(f.snapshot)
+		}
+		err := stream.Send(&payload.Snapshot_Chunk{
+			Data:  f.snapshot[i:end],
+			Index: req.GetIndex(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeAgent) Restore(stream agent.Agent_RestoreServer) error {
+	var buf bytes.Buffer
+	var index string
+	for {
+		c, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		index = c.GetIndex()
+		buf.Write(c.GetData())
+	}
+	f.mu.Lock()
+	f.restored, f.index = buf.Bytes(), index
+	f.mu.Unlock()
+	return stream.SendAndClose(new(payload.Common_Empty))
+}
+
+func newTestBackup(t *testing.T, opts ...BackupOption) (Backup, blob.Bucket, func()) {
+	ctx := context.Background()
+	errgroup.Init(ctx)
+	log.Init(log.DefaultGlg())
+
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Fatalf("Unexpected error: TempDir(%v)", err)
+	}
+	bucket, err := fs.New(fs.WithDir(tmpdir))
+	if err != nil {
+		t.Fatalf("Unexpected error: fs.New(%v)", err)
+	}
+	b, err := NewBackup(append([]BackupOption{WithBackupBucket(bucket)}, opts...)...)
+	if err != nil {
+		t.Fatalf("Unexpected error: NewBackup(%v)", err)
+	}
+	return b, bucket, func() {
+		b.Close()
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	a := &fakeAgent{
+		snapshot: []byte("the snapshot of the index"),
+	}
+	addrs, stop, err := grpctest.NewAgentServers(a)
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+	addr := addrs[0]
+
+	b, _, cleanup := newTestBackup(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	infos, err := b.Backup(ctx, addr, "named")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBackupAndRestore(%v)", err)
+	}
+	if len(infos) != 1 || infos[0].GetSize_() != int64(len(a.snapshot)) ||
+		infos[0].GetAgent() != addr || infos[0].GetIndex() != "named" {
+		t.Fatalf("TestBackupAndRestore: %v, wanted the info of the snapshot", infos)
+	}
+
+	list, err := b.List(ctx, addr, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBackupAndRestore(%v)", err)
+	}
+	if len(list) != 1 || list[0].GetId() != infos[0].GetId() || list[0].GetChecksum() != infos[0].GetChecksum() {
+		t.Errorf("TestBackupAndRestore: %v, wanted: %v", list, infos)
+	}
+
+	if err := b.Restore(ctx, infos[0].GetId(), ""); err != nil {
+		t.Fatalf("Unexpected error: TestBackupAndRestore(%v)", err)
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !bytes.Equal(a.restored, a.snapshot) || a.index != "named" {
+		t.Errorf("TestBackupAndRestore: %s of %s, wanted: %s of named", a.restored, a.index, a.snapshot)
+	}
+}
+
+func TestRestoreChecksumMismatch(t *testing.T) {
+	a := &fakeAgent{
+		snapshot: []byte("the snapshot of the index"),
+	}
+	addrs, stop, err := grpctest.NewAgentServers(a)
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+	addr := addrs[0]
+
+	b, bucket, cleanup := newTestBackup(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	infos, err := b.Backup(ctx, addr, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRestoreChecksumMismatch(%v)", err)
+	}
+	id := infos[0].GetId()
+
+	w, err := bucket.Writer(ctx, path.Join(id, snapshotFile))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestRestoreChecksumMismatch(%v)", err)
+	}
+	io.WriteString(w, "the snapshot of the INDEX")
+	if err := w.Close(); err != nil {
+		t.Fatalf("Unexpected error: TestRestoreChecksumMismatch(%v)", err)
+	}
+
+	err = b.Restore(ctx, id, "")
+	if wants := errors.ErrBackupChecksumMismatch(id); err == nil || err.Error() != wants.Error() {
+		t.Errorf("TestRestoreChecksumMismatch: %v, wanted: %v", err, wants)
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.restored != nil {
+		t.Errorf("TestRestoreChecksumMismatch: %s, wanted nothing restored", a.restored)
+	}
+
+	err = b.Restore(ctx, "missing", "")
+	if wants := errors.ErrBackupNotFound("missing"); err == nil || err.Error() != wants.Error() {
+		t.Errorf("TestRestoreChecksumMismatch: %v, wanted: %v", err, wants)
+	}
+}
+
+func TestBackupRetention(t *testing.T) {
+	addrs, stop, err := grpctest.NewAgentServers(&fakeAgent{
+		snapshot: []byte("snapshot"),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+	addr := addrs[0]
+
+	b, _, cleanup := newTestBackup(t, WithBackupMaxBackups(2))
+	defer cleanup()
+
+	ctx := context.Background()
+	var ids []string
+	for i := 0; i < 3; i++ {
+		infos, err := b.Backup(ctx, addr, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: TestBackupRetention(%v)", err)
+		}
+		ids = append(ids, infos[0].GetId())
+	}
+
+	list, err := b.List(ctx, "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestBackupRetention(%v)", err)
+	}
+	got := make([]string, 0, len(list))
+	for _, info := range list {
+		got = append(got, info.GetId())
+	}
+	if wants := []string{ids[2], ids[1]}; len(got) != len(wants) || got[0] != wants[0] || got[1] != wants[1] {
+		t.Errorf("TestBackupRetention: %v, wanted: %v", got, wants)
+	}
+}
+
+func TestBackupWithoutAgents(t *testing.T) {
+	b, _, cleanup := newTestBackup(t)
+	defer cleanup()
+
+	_, err := b.Backup(context.Background(), "", "")
+	if err != errors.ErrBackupAgentsNotFound {
+		t.Errorf("TestBackupWithoutAgents: %v, wanted: %v", err, errors.ErrBackupAgentsNotFound)
+	}
+}
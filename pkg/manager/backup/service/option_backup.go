@@ -0,0 +1,101 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/timeutil"
+	"google.golang.org/grpc"
+)
+
+type BackupOption func(*backup)
+
+var (
+	defaultBackupOpts = []BackupOption{
+		WithBackupAgentPort(8082),
+		WithBackupDialOptions(grpc.WithInsecure()),
+	}
+)
+
+func WithBackupBucket(bucket blob.Bucket) BackupOption {
+	return func(b *backup) {
+		b.bucket = bucket
+	}
+}
+
+func WithBackupDiscovererAddr(addr string) BackupOption {
+	return func(b *backup) {
+		b.discovererAddr = addr
+	}
+}
+
+func WithBackupAgentPort(port int) BackupOption {
+	return func(b *backup) {
+		if port <= 0 {
+			return
+		}
+		b.agentPort = port
+	}
+}
+
+func WithBackupDialOptions(opts ...grpc.DialOption) BackupOption {
+	return func(b *backup) {
+		b.dialOpts = opts
+	}
+}
+
+func WithBackupDuration(dur string) BackupOption {
+	return func(b *backup) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return
+		}
+		b.duration = d
+	}
+}
+
+func WithBackupMaxBackups(n int) BackupOption {
+	return func(b *backup) {
+		if n < 0 {
+			return
+		}
+		b.maxBackups = n
+	}
+}
+
+func WithBackupMaxAge(age string) BackupOption {
+	return func(b *backup) {
+		if age == "" {
+			return
+		}
+		d, err := timeutil.Parse(age)
+		if err != nil {
+			return
+		}
+		b.maxAge = d
+	}
+}
@@ -2,8 +2,15 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/db/storage/blob/fs"
+	"github.com/vdaas/vald/internal/db/storage/blob/s3"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/pkg/manager/backup/config"
 	"github.com/vdaas/vald/pkg/manager/backup/handler/grpc"
 	"github.com/vdaas/vald/pkg/manager/backup/handler/rest"
@@ -16,14 +23,27 @@ type Runner runner.Runner
 type run struct {
 	cfg    *config.Data
 	server service.Server
+	backup service.Backup
 }
 
 func New(cfg *config.Data) (Runner, error) {
-	ngt, err := service.NewNGT(cfg.NGT)
+	bucket, err := newBucket(cfg)
 	if err != nil {
 		return nil, err
 	}
-	g := grpc.New(grpc.WithNGT(ngt))
+	b, err := service.NewBackup(
+		service.WithBackupBucket(bucket),
+		service.WithBackupDiscovererAddr(cfg.Backup.DiscovererAddr),
+		service.WithBackupAgentPort(cfg.Backup.AgentPort),
+		service.WithBackupDuration(cfg.Backup.BackupDuration),
+		service.WithBackupMaxBackups(cfg.Backup.MaxBackups),
+		service.WithBackupMaxAge(cfg.Backup.MaxAge),
+	)
+	if err != nil {
+		bucket.Close()
+		return nil, err
+	}
+	g := grpc.New(grpc.WithBackup(b))
 
 	srv, err := service.NewServer(
 		service.WithConfig(cfg.Server),
@@ -31,7 +51,7 @@ func New(cfg *config.Data) (Runner, error) {
 			router.New(
 				router.WithHandler(
 					rest.New(
-						rest.WithAgent(g),
+						rest.WithBackup(g),
 					),
 				),
 			),
@@ -41,21 +61,73 @@ func New(cfg *config.Data) (Runner, error) {
 	)
 
 	if err != nil {
+		b.Close()
 		return nil, err
 	}
 
 	return &run{
 		cfg:    cfg,
 		server: srv,
+		backup: b,
 	}, nil
 }
 
+func newBucket(cfg *config.Data) (blob.Bucket, error) {
+	switch strings.ToLower(cfg.Backup.Storage.StorageType) {
+	case "fs", "":
+		return fs.New(
+			fs.WithDir(cfg.Backup.Storage.FS.Dir),
+		)
+	case "s3":
+		return s3.New(
+			s3.WithEndpoint(cfg.Backup.Storage.S3.Endpoint),
+			s3.WithRegion(cfg.Backup.Storage.S3.Region),
+			s3.WithBucket(cfg.Backup.Storage.S3.Bucket),
+			s3.WithPrefix(cfg.Backup.Storage.S3.Prefix),
+			s3.WithAccessKey(cfg.Backup.Storage.S3.AccessKey),
+			s3.WithSecretKey(cfg.Backup.Storage.S3.SecretKey),
+			s3.WithForcePathStyle(cfg.Backup.Storage.S3.ForcePathStyle),
+			s3.WithPartSize(cfg.Backup.Storage.S3.PartSize),
+		)
+	}
+	return nil, errors.ErrInvalidBlobStorage(cfg.Backup.Storage.StorageType)
+}
+
 func (r *run) PreStart() error {
 	return nil
 }
 
 func (r *run) Start(ctx context.Context) <-chan error {
-	return r.server.ListenAndServe(ctx)
+	ech := make(chan error)
+	sech := r.server.ListenAndServe(ctx)
+	bech := r.backup.Start(ctx)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		var ok bool
+		for sech != nil || bech != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err, ok = <-sech:
+				if !ok {
+					sech = nil
+				}
+			case err, ok = <-bech:
+				if !ok {
+					bech = nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		return nil
+	}))
+	return ech
 }
 
 func (r *run) PreStop() error {
@@ -63,5 +135,9 @@ func (r *run) PreStop() error {
 }
 
 func (r *run) Stop(ctx context.Context) error {
-	return r.server.Shutdown(ctx)
+	err := r.server.Shutdown(ctx)
+	if cerr := r.backup.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
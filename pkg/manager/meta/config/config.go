@@ -36,8 +36,8 @@ type Data struct {
 	// Server represent all server configurations
 	Server *config.Servers `json:"server_config" yaml:"server_config"`
 
-	// NGT represent ngt core configuration
-	NGT *config.NGT `json:"ngt" yaml:"ngt"`
+	// Meta represent the metadata store configuration
+	Meta *config.Meta `json:"meta" yaml:"meta"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -50,8 +50,10 @@ func NewConfig(path string) (cfg *Data, err error) {
 	if cfg.Server != nil {
 		cfg.Server = cfg.Server.Bind()
 	}
-	if cfg.NGT != nil {
-		cfg.NGT = cfg.NGT.Bind()
+	if cfg.Meta != nil {
+		cfg.Meta = cfg.Meta.Bind()
+	} else {
+		cfg.Meta = new(config.Meta).Bind()
 	}
 
 	return cfg, nil
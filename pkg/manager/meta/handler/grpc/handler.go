@@ -27,18 +27,16 @@ import (
 	"context"
 	"time"
 
-	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/meta_manager"
 	"github.com/vdaas/vald/apis/grpc/payload"
 	"github.com/vdaas/vald/internal/errors"
-	"github.com/vdaas/vald/internal/net/grpc"
-	"github.com/vdaas/vald/pkg/manager/meta/model"
 	"github.com/vdaas/vald/pkg/manager/meta/service"
 )
 
-type Server agent.AgentServer
+type Server meta_manager.MetaManagerServer
 
 type server struct {
-	ngt service.NGT
+	meta service.Meta
 }
 
 func New(opts ...Option) Server {
@@ -50,184 +48,94 @@ func New(opts ...Option) Server {
 	return s
 }
 
-func (s *server) Exists(ctx context.Context, oid *payload.Object_ID) (*payload.Object_ID, error) {
-	id, ok := s.ngt.Exists(oid.GetId())
-	if !ok {
-		return nil, errors.ErrObjectIDNotFound(oid.GetId())
+func (s *server) GetMeta(ctx context.Context, id *payload.Object_ID) (*payload.Meta_Object, error) {
+	data, err := s.meta.Get(ctx, id.GetId())
+	if err != nil {
+		return nil, err
 	}
-	return &payload.Object_ID{
-		Id: id,
+	return &payload.Meta_Object{
+		Id:   id.GetId(),
+		Data: data,
 	}, nil
 }
 
-func (s *server) Search(ctx context.Context, req *payload.Search_Request) (*payload.Search_Response, error) {
-	return toSearchResponse(
-		s.ngt.Search(
-			req.GetVector().GetVector(),
-			req.GetConfig().GetNum(),
-			req.GetConfig().GetEpsilon(),
-			req.GetConfig().GetRadius()))
-}
-
-func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error) {
-	return toSearchResponse(
-		s.ngt.SearchByID(
-			req.GetId().GetId(),
-			req.GetConfig().GetNum(),
-			req.GetConfig().GetEpsilon(),
-			req.GetConfig().GetRadius()))
-}
-
-func toSearchResponse(dists []model.Distance, err error) (*payload.Search_Response, error) {
+func (s *server) MultiGetMeta(ctx context.Context, ids *payload.Object_IDs) (*payload.Meta_Objects, error) {
+	uuids := make([]string, 0, len(ids.GetIds()))
+	for _, id := range ids.GetIds() {
+		uuids = append(uuids, id.GetId())
+	}
+	data, err := s.meta.MultiGet(ctx, uuids...)
 	if err != nil {
-		return &payload.Search_Response{
-			Error: &payload.Common_Error{
-				Msg:       err.Error(),
-				Timestamp: time.Now().UnixNano(),
-			},
-		}, err
+		return nil, err
 	}
-
-	res := &payload.Search_Response{
-		Results: make([]*payload.Object_Distance, 0, len(dists)),
+	res := &payload.Meta_Objects{
+		Objects: make([]*payload.Meta_Object, 0, len(data)),
 	}
-
-	for _, dist := range dists {
-		// res.Results = append(res.Results, (*payload.Object_Distance)(unsafe.Pointer(&dist)))
-		res.Results = append(res.Results, &payload.Object_Distance{
-			Id: &payload.Object_ID{
-				Id: dist.ID,
-			},
-			Distance: dist.Distance,
+	for i, d := range data {
+		if d == nil {
+			continue
+		}
+		res.Objects = append(res.Objects, &payload.Meta_Object{
+			Id:   uuids[i],
+			Data: d,
 		})
 	}
-
 	return res, nil
 }
 
-func (s *server) StreamSearch(stream agent.Agent_StreamSearchServer) error {
-	return grpc.BidirectionalStream(stream, func(ctx context.Context, data interface{}) (interface{}, error) {
-		return s.Search(ctx, data.(*payload.Search_Request))
-	})
-}
-
-func (s *server) StreamSearchByID(stream agent.Agent_StreamSearchByIDServer) error {
-	return grpc.BidirectionalStream(stream, func(ctx context.Context, data interface{}) (interface{}, error) {
-		return s.SearchByID(ctx, data.(*payload.Search_IDRequest))
-	})
-}
-
-func (s *server) Insert(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
-	err := s.ngt.Insert(vec.GetId().GetId(), vec.GetVector())
+func (s *server) SetMeta(ctx context.Context, obj *payload.Meta_Object) (*payload.Common_Error, error) {
+	err := s.meta.Set(ctx, obj.GetId(), obj.GetData())
 	if err != nil {
-		return &payload.Common_Error{
-			Msg:       err.Error(),
-			Timestamp: time.Now().UnixNano(),
-		}, err
+		return toError(obj.GetId(), err), err
 	}
 	return nil, nil
 }
 
-func (s *server) StreamInsert(stream agent.Agent_StreamInsertServer) error {
-	return grpc.BidirectionalStream(stream, func(ctx context.Context, data interface{}) (interface{}, error) {
-		return s.Insert(ctx, data.(*payload.Object_Vector))
-	})
-}
-
-func (s *server) MultiInsert(ctx context.Context, vecs *payload.Object_Vectors) (res *payload.Common_Errors, err error) {
-	res = new(payload.Common_Errors)
-	for _, vec := range vecs.GetVectors() {
-		r, ierr := s.Insert(ctx, vec)
-		if ierr != nil {
-			err = errors.Wrap(err, ierr.Error())
-			res.Errors = append(res.Errors, r)
+func (s *server) MultiSetMeta(ctx context.Context, objs *payload.Meta_Objects) (*payload.Common_Errors, error) {
+	res := new(payload.Common_Errors)
+	data := make(map[string]map[string]string, len(objs.GetObjects()))
+	for _, obj := range objs.GetObjects() {
+		uuid := obj.GetId()
+		if _, ok := data[uuid]; ok {
+			res.Errors = append(res.Errors, toError(uuid, errors.ErrUUIDDuplicated(uuid)))
+			continue
 		}
+		data[uuid] = obj.GetData()
 	}
-	return
-}
-
-func (s *server) Update(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
-	err := s.ngt.Update(vec.GetId().GetId(), vec.GetVector())
-	if err != nil {
-		return &payload.Common_Error{
-			Msg:       err.Error(),
-			Timestamp: time.Now().UnixNano(),
-		}, err
-	}
-	return nil, nil
-}
-
-func (s *server) StreamUpdate(stream agent.Agent_StreamUpdateServer) error {
-	return grpc.BidirectionalStream(stream, func(ctx context.Context, data interface{}) (interface{}, error) {
-		return s.Update(ctx, data.(*payload.Object_Vector))
-	})
-}
-
-func (s *server) MultiUpdate(ctx context.Context, vecs *payload.Object_Vectors) (res *payload.Common_Errors, err error) {
-	res = new(payload.Common_Errors)
-	for _, vec := range vecs.GetVectors() {
-		r, ierr := s.Update(ctx, vec)
-		if ierr != nil {
-			err = errors.Wrap(err, ierr.Error())
-			res.Errors = append(res.Errors, r)
+	if err := s.meta.MultiSet(ctx, data); err != nil {
+		for uuid := range data {
+			res.Errors = append(res.Errors, toError(uuid, err))
 		}
 	}
-	return
+	return res, nil
 }
 
-func (s *server) Remove(ctx context.Context, id *payload.Object_ID) (*payload.Common_Error, error) {
-	err := s.ngt.Delete(id.GetId())
+func (s *server) DeleteMeta(ctx context.Context, id *payload.Object_ID) (*payload.Common_Error, error) {
+	err := s.meta.Delete(ctx, id.GetId())
 	if err != nil {
-		return &payload.Common_Error{
-			Msg:       err.Error(),
-			Timestamp: time.Now().UnixNano(),
-		}, err
+		return toError(id.GetId(), err), err
 	}
 	return nil, nil
 }
 
-func (s *server) StreamRemove(stream agent.Agent_StreamRemoveServer) error {
-	return grpc.BidirectionalStream(stream, func(ctx context.Context, data interface{}) (interface{}, error) {
-		return s.Remove(ctx, data.(*payload.Object_ID))
-	})
-}
-
-func (s *server) MultiRemove(ctx context.Context, ids *payload.Object_IDs) (res *payload.Common_Errors, err error) {
-	res = new(payload.Common_Errors)
+func (s *server) MultiDeleteMeta(ctx context.Context, ids *payload.Object_IDs) (*payload.Common_Errors, error) {
+	res := new(payload.Common_Errors)
+	uuids := make([]string, 0, len(ids.GetIds()))
 	for _, id := range ids.GetIds() {
-		r, ierr := s.Remove(ctx, id)
-		if ierr != nil {
-			err = errors.Wrap(err, ierr.Error())
-			res.Errors = append(res.Errors, r)
+		uuids = append(uuids, id.GetId())
+	}
+	if err := s.meta.Delete(ctx, uuids...); err != nil {
+		for _, uuid := range uuids {
+			res.Errors = append(res.Errors, toError(uuid, err))
 		}
 	}
-	return
+	return res, nil
 }
 
-func (s *server) GetObject(ctx context.Context, id *payload.Object_ID) (*payload.Object_Vector, error) {
-	vec, err := s.ngt.GetObject(id.GetId())
-	if err != nil {
-		return nil, err
+func toError(uuid string, err error) *payload.Common_Error {
+	return &payload.Common_Error{
+		Id:        uuid,
+		Msg:       err.Error(),
+		Timestamp: time.Now().UnixNano(),
 	}
-	return &payload.Object_Vector{
-		Id: &payload.Object_ID{
-			Id: id.GetId(),
-		},
-		Vector: vec,
-	}, nil
-
-}
-
-func (s *server) StreamGetObject(stream agent.Agent_StreamGetObjectServer) error {
-	return grpc.BidirectionalStream(stream, func(ctx context.Context, data interface{}) (interface{}, error) {
-		return s.GetObject(ctx, data.(*payload.Object_ID))
-	})
-}
-
-func (s *server) CreateIndex(ctx context.Context, c *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error) {
-	return nil, s.ngt.CreateIndex(c.GetPoolSize())
-}
-
-func (s *server) SaveIndex(context.Context, *payload.Common_Empty) (*payload.Common_Empty, error) {
-	return nil, s.ngt.SaveIndex()
 }
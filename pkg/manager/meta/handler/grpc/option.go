@@ -31,8 +31,8 @@ var (
 	defaultOpts = []Option{}
 )
 
-func WithNGT(n service.NGT) Option {
+func WithMeta(m service.Meta) Option {
 	return func(s *server) {
-		s.ngt = n
+		s.meta = m
 	}
 }
@@ -30,28 +30,22 @@ import (
 	"io/ioutil"
 	"net/http"
 
-	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/meta_manager"
 	"github.com/vdaas/vald/apis/grpc/payload"
 )
 
 type Handler interface {
 	Index(w http.ResponseWriter, r *http.Request) error
-	Exists(w http.ResponseWriter, r *http.Request) error
-	Search(w http.ResponseWriter, r *http.Request) error
-	SearchByID(w http.ResponseWriter, r *http.Request) error
-	Insert(w http.ResponseWriter, r *http.Request) error
-	MultiInsert(w http.ResponseWriter, r *http.Request) error
-	Update(w http.ResponseWriter, r *http.Request) error
-	MultiUpdate(w http.ResponseWriter, r *http.Request) error
-	Remove(w http.ResponseWriter, r *http.Request) error
-	MultiRemove(w http.ResponseWriter, r *http.Request) error
-	CreateIndex(w http.ResponseWriter, r *http.Request) error
-	SaveIndex(w http.ResponseWriter, r *http.Request) error
-	GetObject(w http.ResponseWriter, r *http.Request) error
+	GetMeta(w http.ResponseWriter, r *http.Request) error
+	MultiGetMeta(w http.ResponseWriter, r *http.Request) error
+	SetMeta(w http.ResponseWriter, r *http.Request) error
+	MultiSetMeta(w http.ResponseWriter, r *http.Request) error
+	DeleteMeta(w http.ResponseWriter, r *http.Request) error
+	MultiDeleteMeta(w http.ResponseWriter, r *http.Request) error
 }
 
 type handler struct {
-	agent agent.AgentServer
+	meta meta_manager.MetaManagerServer
 }
 
 func New(opts ...Option) Handler {
@@ -68,53 +62,15 @@ func (h *handler) Index(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
-func (h *handler) Search(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Search_Request
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return err
-	}
-	io.Copy(ioutil.Discard, r.Body)
-	r.Body.Close()
-	res, err := h.agent.Search(r.Context(), req)
-	if err != nil {
-		return err
-	}
-	err = json.NewEncoder(w).Encode(res)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (h *handler) SearchByID(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Search_IDRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return err
-	}
-	io.Copy(ioutil.Discard, r.Body)
-	r.Body.Close()
-	res, err := h.agent.SearchByID(r.Context(), req)
-	if err != nil {
-		return err
-	}
-	err = json.NewEncoder(w).Encode(res)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (h *handler) Insert(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Object_Vector
+func (h *handler) GetMeta(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Object_ID
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		return err
 	}
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	res, err := h.agent.Insert(r.Context(), req)
+	res, err := h.meta.GetMeta(r.Context(), req)
 	if err != nil {
 		return err
 	}
@@ -125,15 +81,15 @@ func (h *handler) Insert(w http.ResponseWriter, r *http.Request) (err error) {
 	return nil
 }
 
-func (h *handler) MultiInsert(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Object_Vectors
+func (h *handler) MultiGetMeta(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Object_IDs
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		return err
 	}
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	res, err := h.agent.MultiInsert(r.Context(), req)
+	res, err := h.meta.MultiGetMeta(r.Context(), req)
 	if err != nil {
 		return err
 	}
@@ -144,15 +100,15 @@ func (h *handler) MultiInsert(w http.ResponseWriter, r *http.Request) (err error
 	return nil
 }
 
-func (h *handler) Update(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Object_Vector
+func (h *handler) SetMeta(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Meta_Object
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		return err
 	}
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	res, err := h.agent.Update(r.Context(), req)
+	res, err := h.meta.SetMeta(r.Context(), req)
 	if err != nil {
 		return err
 	}
@@ -163,15 +119,15 @@ func (h *handler) Update(w http.ResponseWriter, r *http.Request) (err error) {
 	return nil
 }
 
-func (h *handler) MultiUpdate(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Object_Vectors
+func (h *handler) MultiSetMeta(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Meta_Objects
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		return err
 	}
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	res, err := h.agent.MultiUpdate(r.Context(), req)
+	res, err := h.meta.MultiSetMeta(r.Context(), req)
 	if err != nil {
 		return err
 	}
@@ -182,7 +138,7 @@ func (h *handler) MultiUpdate(w http.ResponseWriter, r *http.Request) (err error
 	return nil
 }
 
-func (h *handler) Remove(w http.ResponseWriter, r *http.Request) (err error) {
+func (h *handler) DeleteMeta(w http.ResponseWriter, r *http.Request) (err error) {
 	var req *payload.Object_ID
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
@@ -190,7 +146,7 @@ func (h *handler) Remove(w http.ResponseWriter, r *http.Request) (err error) {
 	}
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	res, err := h.agent.Remove(r.Context(), req)
+	res, err := h.meta.DeleteMeta(r.Context(), req)
 	if err != nil {
 		return err
 	}
@@ -201,7 +157,7 @@ func (h *handler) Remove(w http.ResponseWriter, r *http.Request) (err error) {
 	return nil
 }
 
-func (h *handler) MultiRemove(w http.ResponseWriter, r *http.Request) (err error) {
+func (h *handler) MultiDeleteMeta(w http.ResponseWriter, r *http.Request) (err error) {
 	var req *payload.Object_IDs
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
@@ -209,71 +165,7 @@ func (h *handler) MultiRemove(w http.ResponseWriter, r *http.Request) (err error
 	}
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	res, err := h.agent.MultiRemove(r.Context(), req)
-	if err != nil {
-		return err
-	}
-	err = json.NewEncoder(w).Encode(res)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (h *handler) CreateIndex(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Controll_CreateIndexRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return err
-	}
-	io.Copy(ioutil.Discard, r.Body)
-	r.Body.Close()
-	res, err := h.agent.CreateIndex(r.Context(), req)
-	if err != nil {
-		return err
-	}
-	err = json.NewEncoder(w).Encode(res)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (h *handler) SaveIndex(w http.ResponseWriter, r *http.Request) (err error) {
-	io.Copy(ioutil.Discard, r.Body)
-	r.Body.Close()
-	_, err = h.agent.SaveIndex(r.Context(), nil)
-	return
-}
-
-func (h *handler) GetObject(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Object_ID
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return err
-	}
-	io.Copy(ioutil.Discard, r.Body)
-	r.Body.Close()
-	res, err := h.agent.GetObject(r.Context(), req)
-	if err != nil {
-		return err
-	}
-	err = json.NewEncoder(w).Encode(res)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (h *handler) Exists(w http.ResponseWriter, r *http.Request) (err error) {
-	var req *payload.Object_ID
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return err
-	}
-	io.Copy(ioutil.Discard, r.Body)
-	r.Body.Close()
-	res, err := h.agent.Exists(r.Context(), req)
+	res, err := h.meta.MultiDeleteMeta(r.Context(), req)
 	if err != nil {
 		return err
 	}
@@ -57,97 +57,53 @@ func New(opts ...Option) http.Handler {
 				h.Index,
 			},
 			{
-				"Search",
-				[]string{
-					http.MethodPost,
-				},
-				"/search",
-				h.Search,
-			},
-			{
-				"Search By ID",
+				"Multiple GetMeta",
 				[]string{
 					http.MethodGet,
-				},
-				"/search/{id}",
-				h.SearchByID,
-			},
-			{
-				"Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert",
-				h.Insert,
-			},
-			{
-				"Multiple Insert",
-				[]string{
 					http.MethodPost,
 				},
-				"/insert/multi",
-				h.MultiInsert,
+				"/meta/multi/get",
+				h.MultiGetMeta,
 			},
 			{
-				"Update",
+				"Multiple SetMeta",
 				[]string{
 					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
 				},
-				"/update",
-				h.Update,
+				"/meta/multi",
+				h.MultiSetMeta,
 			},
 			{
-				"Multiple Update",
-				[]string{
-					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
-				},
-				"/update/multi",
-				h.MultiUpdate,
-			},
-			{
-				"Remove",
-				[]string{
-					http.MethodDelete,
-				},
-				"/delete/{id}",
-				h.Remove,
-			},
-			{
-				"Multiple Remove",
+				"Multiple DeleteMeta",
 				[]string{
 					http.MethodDelete,
 					http.MethodPost,
 				},
-				"/delete/multi",
-				h.MultiRemove,
+				"/meta/multi/delete",
+				h.MultiDeleteMeta,
 			},
 			{
-				"Create Index",
+				"SetMeta",
 				[]string{
-					http.MethodGet,
+					http.MethodPost,
 				},
-				"/index/create/{pool}",
-				h.CreateIndex,
+				"/meta",
+				h.SetMeta,
 			},
 			{
-				"Save Index",
+				"GetMeta & DeleteMeta",
 				[]string{
 					http.MethodGet,
+					http.MethodDelete,
 				},
-				"/index/save",
-				h.SaveIndex,
-			},
-			{
-				"GetObject",
-				[]string{
-					http.MethodGet,
+				"/meta/{id}",
+				func(w http.ResponseWriter, r *http.Request) error {
+					// the routes are matched by path only, so both methods share a single route
+					if r.Method == http.MethodDelete {
+						return h.DeleteMeta(w, r)
+					}
+					return h.GetMeta(w, r)
 				},
-				"/object/{id}",
-				h.GetObject,
 			},
 		}...),
 		routing.WithTimeout(r.timeout))
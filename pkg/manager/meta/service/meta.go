@@ -0,0 +1,130 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// Meta stores the user metadata of each uuid.
+type Meta interface {
+	Get(ctx context.Context, uuid string) (map[string]string, error)
+	// MultiGet returns the metadata of the uuids in order, the metadata of the missing uuid is nil.
+	MultiGet(ctx context.Context, uuids ...string) ([]map[string]string, error)
+	// Set replaces the whole metadata of the uuid.
+	Set(ctx context.Context, uuid string, data map[string]string) error
+	MultiSet(ctx context.Context, data map[string]map[string]string) error
+	// Delete removes the metadata of the uuids, the missing uuids are ignored.
+	Delete(ctx context.Context, uuids ...string) error
+	Close() error
+}
+
+// Store is the key value store of the encoded metadata, which bbolt.Bolt and redis.Redis implement.
+type Store interface {
+	Get(ctx context.Context, keys ...string) ([][]byte, error)
+	Set(ctx context.Context, kvs map[string][]byte) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+type meta struct {
+	store Store
+}
+
+func NewMeta(opts ...MetaOption) (Meta, error) {
+	m := new(meta)
+	for _, opt := range append(defaultMetaOpts, opts...) {
+		opt(m)
+	}
+	if m.store == nil {
+		return nil, errors.ErrInvalidMetaStore("")
+	}
+	return m, nil
+}
+
+func (m *meta) Get(ctx context.Context, uuid string) (map[string]string, error) {
+	data, err := m.MultiGet(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if data[0] == nil {
+		return nil, errors.ErrMetaNotFound(uuid)
+	}
+	return data[0], nil
+}
+
+func (m *meta) MultiGet(ctx context.Context, uuids ...string) ([]map[string]string, error) {
+	vals, err := m.store.Get(ctx, uuids...)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]map[string]string, len(vals))
+	for i, val := range vals {
+		if val == nil {
+			continue
+		}
+		if err := json.Unmarshal(val, &data[i]); err != nil {
+			return nil, err
+		}
+		if data[i] == nil {
+			data[i] = make(map[string]string)
+		}
+	}
+	return data, nil
+}
+
+func (m *meta) Set(ctx context.Context, uuid string, data map[string]string) error {
+	return m.MultiSet(ctx, map[string]map[string]string{
+		uuid: data,
+	})
+}
+
+func (m *meta) MultiSet(ctx context.Context, data map[string]map[string]string) error {
+	kvs := make(map[string][]byte, len(data))
+	for uuid, d := range data {
+		if uuid == "" {
+			return errors.ErrEmptyMetaUUID
+		}
+		if d == nil {
+			d = make(map[string]string)
+		}
+		val, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		kvs[uuid] = val
+	}
+	return m.store.Set(ctx, kvs)
+}
+
+func (m *meta) Delete(ctx context.Context, uuids ...string) error {
+	return m.store.Delete(ctx, uuids...)
+}
+
+func (m *meta) Close() error {
+	return m.store.Close()
+}
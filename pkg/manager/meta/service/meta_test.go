@@ -0,0 +1,114 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/vdaas/vald/internal/db/kvs/bbolt"
+	"github.com/vdaas/vald/internal/db/kvs/redis"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestMeta(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "tmpdir")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestMeta(%v)", err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestMeta(%v)", err)
+	}
+	defer mr.Close()
+
+	stores := map[string]func() (Store, error){
+		"bbolt": func() (Store, error) {
+			return bbolt.New(bbolt.WithPath(filepath.Join(tmpdir, "meta.db")))
+		},
+		"redis": func() (Store, error) {
+			return redis.New(redis.WithAddrs(mr.Addr()))
+		},
+	}
+
+	for name, open := range stores {
+		t.Run(name, func(t *testing.T) {
+			store, err := open()
+			if err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+			m, err := NewMeta(WithMetaStore(store))
+			if err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+			defer m.Close()
+
+			ctx := context.Background()
+			err = m.MultiSet(ctx, map[string]map[string]string{
+				"uuid-1": {"title": "a", "lang": "ja"},
+				"uuid-2": nil,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+			if err := m.Set(ctx, "uuid-1", map[string]string{"title": "b"}); err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+
+			got, err := m.Get(ctx, "uuid-1")
+			if err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+			if wants := map[string]string{"title": "b"}; !reflect.DeepEqual(got, wants) {
+				t.Errorf("TestMeta: %v, wanted: %v", got, wants)
+			}
+
+			all, err := m.MultiGet(ctx, "uuid-2", "uuid-3", "uuid-1")
+			if err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+			if wants := []map[string]string{{}, nil, {"title": "b"}}; !reflect.DeepEqual(all, wants) {
+				t.Errorf("TestMeta: %v, wanted: %v", all, wants)
+			}
+
+			if err := m.Delete(ctx, "uuid-1", "uuid-3"); err != nil {
+				t.Fatalf("Unexpected error: TestMeta(%v)", err)
+			}
+			if _, err := m.Get(ctx, "uuid-1"); err == nil || err.Error() != errors.ErrMetaNotFound("uuid-1").Error() {
+				t.Errorf("TestMeta: %v, wanted: %v", err, errors.ErrMetaNotFound("uuid-1"))
+			}
+
+			if err := m.Set(ctx, "", nil); err != errors.ErrEmptyMetaUUID {
+				t.Errorf("TestMeta: %v, wanted: %v", err, errors.ErrEmptyMetaUUID)
+			}
+		})
+	}
+}
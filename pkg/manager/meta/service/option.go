@@ -26,7 +26,7 @@ package service
 import (
 	"net/http"
 
-	"github.com/vdaas/vald/apis/grpc/agent"
+	pb "github.com/vdaas/vald/apis/grpc/meta_manager"
 	"github.com/vdaas/vald/internal/config"
 )
 
@@ -38,7 +38,7 @@ func WithConfig(cfg *config.Servers) Option {
 	}
 }
 
-func WithGRPC(srv agent.AgentServer) Option {
+func WithGRPC(srv pb.MetaManagerServer) Option {
 	return func(s *srvs) {
 		s.grpc = srv
 	}
@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
+	"github.com/vdaas/vald/internal/db/kvs/bbolt"
+	"github.com/vdaas/vald/internal/db/kvs/redis"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/runner"
 	"github.com/vdaas/vald/pkg/manager/meta/config"
 	"github.com/vdaas/vald/pkg/manager/meta/handler/grpc"
@@ -16,14 +20,20 @@ type Runner runner.Runner
 type run struct {
 	cfg    *config.Data
 	server service.Server
+	meta   service.Meta
 }
 
 func New(cfg *config.Data) (Runner, error) {
-	ngt, err := service.NewNGT(cfg.NGT)
+	store, err := newStore(cfg)
 	if err != nil {
 		return nil, err
 	}
-	g := grpc.New(grpc.WithNGT(ngt))
+	m, err := service.NewMeta(service.WithMetaStore(store))
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	g := grpc.New(grpc.WithMeta(m))
 
 	srv, err := service.NewServer(
 		service.WithConfig(cfg.Server),
@@ -31,7 +41,7 @@ func New(cfg *config.Data) (Runner, error) {
 			router.New(
 				router.WithHandler(
 					rest.New(
-						rest.WithAgent(g),
+						rest.WithMeta(g),
 					),
 				),
 			),
@@ -41,15 +51,42 @@ func New(cfg *config.Data) (Runner, error) {
 	)
 
 	if err != nil {
+		m.Close()
 		return nil, err
 	}
 
 	return &run{
 		cfg:    cfg,
 		server: srv,
+		meta:   m,
 	}, nil
 }
 
+// newStore opens the configured store, the embedded bbolt store is used by default.
+func newStore(cfg *config.Data) (service.Store, error) {
+	switch strings.ToLower(cfg.Meta.Store) {
+	case "bbolt", "":
+		return bbolt.New(
+			bbolt.WithPath(cfg.Meta.BBolt.Path),
+			bbolt.WithBucket(cfg.Meta.BBolt.Bucket),
+			bbolt.WithOpenTimeout(cfg.Meta.BBolt.OpenTimeout),
+			bbolt.WithNoSync(cfg.Meta.BBolt.NoSync),
+		)
+	case "redis":
+		return redis.New(
+			redis.WithAddrs(cfg.Meta.Redis.Addrs...),
+			redis.WithPassword(cfg.Meta.Redis.Password),
+			redis.WithDB(cfg.Meta.Redis.DB),
+			redis.WithKeyPrefix(cfg.Meta.Redis.KeyPrefix),
+			redis.WithDialTimeout(cfg.Meta.Redis.DialTimeout),
+			redis.WithReadTimeout(cfg.Meta.Redis.ReadTimeout),
+			redis.WithWriteTimeout(cfg.Meta.Redis.WriteTimeout),
+			redis.WithPoolSize(cfg.Meta.Redis.PoolSize),
+		)
+	}
+	return nil, errors.ErrInvalidMetaStore(cfg.Meta.Store)
+}
+
 func (r *run) PreStart() error {
 	return nil
 }
@@ -63,5 +100,9 @@ func (r *run) PreStop() error {
 }
 
 func (r *run) Stop(ctx context.Context) error {
-	return r.server.Shutdown(ctx)
+	err := r.server.Shutdown(ctx)
+	if cerr := r.meta.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
@@ -36,8 +36,8 @@ type Data struct {
 	// Server represent all server configurations
 	Server *config.Servers `json:"server_config" yaml:"server_config"`
 
-	// NGT represent ngt core configuration
-	NGT *config.NGT `json:"ngt" yaml:"ngt"`
+	// Replication represent the replication manager configuration
+	Replication *config.Replication `json:"replication" yaml:"replication"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -50,8 +50,10 @@ func NewConfig(path string) (cfg *Data, err error) {
 	if cfg.Server != nil {
 		cfg.Server = cfg.Server.Bind()
 	}
-	if cfg.NGT != nil {
-		cfg.NGT = cfg.NGT.Bind()
+	if cfg.Replication != nil {
+		cfg.Replication = cfg.Replication.Bind()
+	} else {
+		cfg.Replication = new(config.Replication).Bind()
 	}
 
 	return cfg, nil
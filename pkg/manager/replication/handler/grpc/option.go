@@ -23,7 +23,7 @@
 // Package grpc provides grpc server logic
 package grpc
 
-import "github.com/vdaas/vald/pkg/agent/ngt/service"
+import "github.com/vdaas/vald/pkg/manager/replication/service"
 
 type Option func(*server)
 
@@ -31,8 +31,8 @@ var (
 	defaultOpts = []Option{}
 )
 
-func WithNGT(n service.NGT) Option {
+func WithReplicator(r service.Replicator) Option {
 	return func(s *server) {
-		s.ngt = n
+		s.replicator = r
 	}
 }
@@ -24,7 +24,7 @@
 package router
 
 import (
-	"github.com/vdaas/vald/pkg/agent/ngt/handler/rest"
+	"github.com/vdaas/vald/pkg/manager/replication/handler/rest"
 )
 
 type Option func(*router)
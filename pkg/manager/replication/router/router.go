@@ -27,7 +27,7 @@ import (
 	"net/http"
 
 	"github.com/vdaas/vald/internal/net/http/routing"
-	"github.com/vdaas/vald/pkg/agent/ngt/handler/rest"
+	"github.com/vdaas/vald/pkg/manager/replication/handler/rest"
 )
 
 type router struct {
@@ -57,97 +57,20 @@ func New(opts ...Option) http.Handler {
 				h.Index,
 			},
 			{
-				"Search",
-				[]string{
-					http.MethodPost,
-				},
-				"/search",
-				h.Search,
-			},
-			{
-				"Search By ID",
+				"Status",
 				[]string{
 					http.MethodGet,
 				},
-				"/search/{id}",
-				h.SearchByID,
-			},
-			{
-				"Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert",
-				h.Insert,
-			},
-			{
-				"Multiple Insert",
-				[]string{
-					http.MethodPost,
-				},
-				"/insert/multi",
-				h.MultiInsert,
-			},
-			{
-				"Update",
-				[]string{
-					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
-				},
-				"/update",
-				h.Update,
-			},
-			{
-				"Multiple Update",
-				[]string{
-					http.MethodPost,
-					http.MethodPatch,
-					http.MethodPut,
-				},
-				"/update/multi",
-				h.MultiUpdate,
+				"/replication/status",
+				h.Status,
 			},
 			{
-				"Remove",
+				"Heal",
 				[]string{
-					http.MethodDelete,
-				},
-				"/delete/{id}",
-				h.Remove,
-			},
-			{
-				"Multiple Remove",
-				[]string{
-					http.MethodDelete,
 					http.MethodPost,
 				},
-				"/delete/multi",
-				h.MultiRemove,
-			},
-			{
-				"Create Index",
-				[]string{
-					http.MethodGet,
-				},
-				"/index/create/{pool}",
-				h.CreateIndex,
-			},
-			{
-				"Save Index",
-				[]string{
-					http.MethodGet,
-				},
-				"/index/save",
-				h.SaveIndex,
-			},
-			{
-				"GetObject",
-				[]string{
-					http.MethodGet,
-				},
-				"/object/{id}",
-				h.GetObject,
+				"/replication/heal",
+				h.Heal,
 			},
 		}...),
 		routing.WithTimeout(r.timeout))
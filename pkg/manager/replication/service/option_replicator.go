@@ -0,0 +1,102 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"github.com/vdaas/vald/internal/placement"
+	"github.com/vdaas/vald/internal/timeutil"
+	"google.golang.org/grpc"
+)
+
+type ReplicatorOption func(*replicator)
+
+var (
+	defaultReplicatorOpts = []ReplicatorOption{
+		WithReplicatorAgentPort(8082),
+		WithReplicatorCheckDuration("1m"),
+		WithReplicatorConcurrency(10),
+		WithReplicatorDialOptions(grpc.WithInsecure()),
+	}
+)
+
+func WithReplicatorDiscovererAddr(addr string) ReplicatorOption {
+	return func(r *replicator) {
+		r.discovererAddr = addr
+	}
+}
+
+func WithReplicatorAgentPort(port int) ReplicatorOption {
+	return func(r *replicator) {
+		if port <= 0 {
+			return
+		}
+		r.agentPort = port
+	}
+}
+
+func WithReplicatorIndex(index string) ReplicatorOption {
+	return func(r *replicator) {
+		r.index = index
+	}
+}
+
+func WithReplicatorCheckDuration(dur string) ReplicatorOption {
+	return func(r *replicator) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil || d <= 0 {
+			return
+		}
+		r.duration = d
+	}
+}
+
+func WithReplicatorConcurrency(n int) ReplicatorOption {
+	return func(r *replicator) {
+		if n <= 0 {
+			return
+		}
+		r.concurrency = n
+	}
+}
+
+func WithReplicatorDialOptions(opts ...grpc.DialOption) ReplicatorOption {
+	return func(r *replicator) {
+		r.dialOpts = opts
+	}
+}
+
+func WithReplicatorVirtualNodes(n int) ReplicatorOption {
+	return func(r *replicator) {
+		r.placementOpts = append(r.placementOpts, placement.WithVirtualNodes(n))
+	}
+}
+
+func WithReplicatorReplicas(n int) ReplicatorOption {
+	return func(r *replicator) {
+		r.placementOpts = append(r.placementOpts, placement.WithReplicas(n))
+	}
+}
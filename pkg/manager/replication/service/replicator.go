@@ -0,0 +1,386 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	igrpc "github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/placement"
+	"github.com/vdaas/vald/internal/safety"
+	"google.golang.org/grpc"
+)
+
+// Replicator compares the uuids held by the agents and copies the uuids missing on their owners
+// from a surviving owner, the owners are decided by the same consistent hashing as the gateway.
+type Replicator interface {
+	// Start checks the agents every check duration or when Heal is called.
+	Start(ctx context.Context) <-chan error
+	// Heal requests the check to start now unless it is running, and returns the current status.
+	Heal() *payload.Replication_Status
+	// Status returns the progress of the running check, or the result of the last one.
+	Status() *payload.Replication_Status
+	Close() error
+}
+
+type replicator struct {
+	pool           igrpc.Pool
+	placement      placement.Placement
+	placementOpts  []placement.Option
+	dialOpts       []grpc.DialOption
+	discovererAddr string
+	discoverer     *grpc.ClientConn
+	agentPort      int
+	index          string
+	duration       time.Duration
+	concurrency    int
+	trigger        chan struct{}
+
+	mu     sync.RWMutex
+	status payload.Replication_Status
+}
+
+// copyTask copies the uuid from the source agent to the owners missing it.
+type copyTask struct {
+	uuid string
+	src  string
+	dsts []string
+}
+
+func NewReplicator(opts ...ReplicatorOption) (Replicator, error) {
+	r := &replicator{
+		trigger: make(chan struct{}, 1),
+	}
+	for _, opt := range append(defaultReplicatorOpts, opts...) {
+		opt(r)
+	}
+	r.pool = igrpc.NewPool(r.dialOpts...)
+	r.placement = placement.New(r.placementOpts...)
+
+	if r.discovererAddr != "" {
+		conn, err := grpc.Dial(r.discovererAddr, r.dialOpts...)
+		if err != nil {
+			return nil, errors.ErrGRPCDialFailed(err, r.discovererAddr)
+		}
+		r.discoverer = conn
+	}
+	return r, nil
+}
+
+func (r *replicator) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	if r.discoverer == nil {
+		close(ech)
+		return ech
+	}
+
+	errgroup.Go(safety.RecoverFunc(func() error {
+		defer close(ech)
+
+		t := time.NewTicker(r.duration)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			case <-r.trigger:
+			}
+			err := r.check(ctx)
+			if err != nil && ctx.Err() == nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+	}))
+	return ech
+}
+
+func (r *replicator) Heal() *payload.Replication_Status {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+	return r.Status()
+}
+
+func (r *replicator) Status() *payload.Replication_Status {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	st := r.status
+	return &st
+}
+
+// check updates the agents from the discoverer and heals the uuids on them.
+func (r *replicator) check(ctx context.Context) error {
+	addrs, err := membership.Discover(ctx, r.discoverer, r.agentPort)
+	if err == nil {
+		err = r.update(ctx, addrs)
+	}
+	if err != nil {
+		r.finish(err)
+		return err
+	}
+	return r.heal(ctx)
+}
+
+// update replaces the agent connections and their placement on the hash ring.
+func (r *replicator) update(ctx context.Context, addrs []string) error {
+	err := r.pool.Update(ctx, addrs)
+	if err != nil {
+		return err
+	}
+	r.placement.Update(r.pool.Addrs())
+	return nil
+}
+
+// heal lists the uuids of every agent and copies the uuids to their owners not holding them.
+// The check is aborted when any agent fails to list, as its uuids would be taken as lost.
+func (r *replicator) heal(ctx context.Context) error {
+	addrs := r.pool.Addrs()
+	r.mu.Lock()
+	r.status = payload.Replication_Status{
+		Running:   true,
+		StartedAt: time.Now().UnixNano(),
+		Agents:    uint32(len(addrs)),
+		Runs:      r.status.GetRuns(),
+	}
+	r.mu.Unlock()
+	if len(addrs) == 0 {
+		r.finish(errors.ErrReplicationAgentsNotFound)
+		return errors.ErrReplicationAgentsNotFound
+	}
+
+	holders, err := r.list(ctx, addrs)
+	if err != nil {
+		r.finish(err)
+		return err
+	}
+
+	var (
+		tasks  = make([]copyTask, 0)
+		orphan int
+	)
+	for uuid, hs := range holders {
+		var src string
+		var dsts []string
+		for _, owner := range r.placement.Owners(uuid) {
+			if !contains(hs, owner) {
+				dsts = append(dsts, owner)
+			} else if src == "" {
+				src = owner
+			}
+		}
+		if len(dsts) == 0 {
+			continue
+		}
+		// the uuid held only by the agents not owning it any more may be a stale copy removed through its owners
+		if src == "" {
+			orphan++
+			continue
+		}
+		tasks = append(tasks, copyTask{
+			uuid: uuid,
+			src:  src,
+			dsts: dsts,
+		})
+	}
+	r.mu.Lock()
+	r.status.Objects = uint64(len(holders))
+	r.status.UnderReplicated = uint64(len(tasks))
+	r.mu.Unlock()
+	log.Infof("replication found %d under-replicated uuids of %d on %d agents, skipped %d uuids held by no owner", len(tasks), len(holders), len(addrs), orphan)
+
+	var (
+		sem   = make(chan struct{}, r.concurrency)
+		_, eg = errgroup.New(ctx)
+	)
+	for _, task := range tasks {
+		task := task
+		select {
+		case <-ctx.Done():
+			eg.Wait()
+			r.finish(ctx.Err())
+			return ctx.Err()
+		case sem <- struct{}{}:
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			defer func() {
+				<-sem
+			}()
+			healed, err := r.copy(ctx, task)
+			r.mu.Lock()
+			r.status.Healed += uint64(healed)
+			r.status.Failed += uint64(len(task.dsts) - healed)
+			r.mu.Unlock()
+			if err != nil {
+				log.Warnf("failed to replicate uuid %s: %v", task.uuid, err)
+			}
+			return nil
+		}))
+	}
+	eg.Wait()
+	r.finish(nil)
+	return nil
+}
+
+// list returns the addresses of the agents holding each uuid.
+func (r *replicator) list(ctx context.Context, addrs []string) (map[string][]string, error) {
+	var (
+		mu      sync.Mutex
+		holders = make(map[string][]string)
+		lerr    error
+		_, eg   = errgroup.New(ctx)
+	)
+	for _, addr := range addrs {
+		addr := addr
+		conn, ok := r.pool.Get(addr)
+		if !ok {
+			return nil, errors.ErrReplicationListFailed(errors.ErrAgentsNotFound, addr)
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			err := r.listAgent(ctx, agent.NewAgentClient(conn), func(uuid string) {
+				mu.Lock()
+				holders[uuid] = append(holders[uuid], addr)
+				mu.Unlock()
+			})
+			if err != nil {
+				mu.Lock()
+				lerr = errors.Wrap(lerr, errors.ErrReplicationListFailed(err, addr).Error())
+				mu.Unlock()
+			}
+			return nil
+		}))
+	}
+	eg.Wait()
+	if lerr != nil {
+		return nil, lerr
+	}
+	return holders, nil
+}
+
+func (r *replicator) listAgent(ctx context.Context, ac agent.AgentClient, f func(uuid string)) error {
+	stream, err := ac.StreamListObject(ctx, &payload.Controll_IndexRequest{
+		Index: r.index,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		id, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f(id.GetId())
+	}
+}
+
+// copy reads the vector from the source owner and inserts it into the destinations while the source still has it,
+// and returns the number of the copies made.
+func (r *replicator) copy(ctx context.Context, task copyTask) (int, error) {
+	conn, ok := r.pool.Get(task.src)
+	if !ok {
+		return 0, errors.ErrAgentRequestFailed(errors.ErrAgentsNotFound, task.src)
+	}
+	src := agent.NewAgentClient(conn)
+	id := &payload.Object_ID{
+		Id:    task.uuid,
+		Index: r.index,
+	}
+	vec, err := src.GetObject(ctx, id)
+	if err != nil {
+		return 0, errors.ErrAgentRequestFailed(err, task.src)
+	}
+
+	var healed int
+	for _, dst := range task.dsts {
+		// the uuid removed from the owners after the listing must not be inserted back
+		_, eerr := src.Exists(ctx, id)
+		if eerr != nil {
+			return healed, errors.Wrap(err, errors.ErrAgentRequestFailed(eerr, task.src).Error())
+		}
+		conn, ok := r.pool.Get(dst)
+		if !ok {
+			err = errors.Wrap(err, errors.ErrAgentRequestFailed(errors.ErrAgentsNotFound, dst).Error())
+			continue
+		}
+		_, ierr := agent.NewAgentClient(conn).Insert(ctx, &payload.Object_Vector{
+			Id: &payload.Object_ID{
+				Id: task.uuid,
+			},
+			Vector: vec.GetVector(),
+			Index:  r.index,
+		})
+		if ierr != nil {
+			err = errors.Wrap(err, errors.ErrAgentRequestFailed(ierr, dst).Error())
+			continue
+		}
+		healed++
+	}
+	return healed, err
+}
+
+// finish records the end of the check and its error.
+func (r *replicator) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status.Running = false
+	r.status.FinishedAt = time.Now().UnixNano()
+	r.status.Runs++
+	r.status.Error = ""
+	if err != nil {
+		r.status.Error = err.Error()
+	}
+}
+
+func (r *replicator) Close() error {
+	if r.discoverer != nil {
+		r.discoverer.Close()
+	}
+	return r.pool.Close()
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc/grpctest"
+	"github.com/vdaas/vald/internal/placement"
+)
+
+type fakeAgent struct {
+	agent.UnimplementedAgentServer
+	mu      sync.Mutex
+	vectors map[string][]float64
+}
+
+func (f *fakeAgent) StreamListObject(req *payload.Controll_IndexRequest, stream agent.Agent_StreamListObjectServer) error {
+	f.mu.Lock()
+	uuids := make([]string, 0, len(f.vectors))
+	for uuid := range f.vectors {
+		uuids = append(uuids, uuid)
+	}
+	f.mu.Unlock()
+	for _, uuid := range uuids {
+		if err := stream.Send(&payload.Object_ID{Id: uuid}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeAgent) GetObject(ctx context.Context, id *payload.Object_ID) (*payload.Object_Vector, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	vec, ok := f.vectors[id.GetId()]
+	if !ok {
+		return nil, errors.ErrObjectIDNotFound(id.GetId())
+	}
+	return &payload.Object_Vector{
+		Id:     id,
+		Vector: vec,
+	}, nil
+}
+
+func (f *fakeAgent) Exists(ctx context.Context, id *payload.Object_ID) (*payload.Object_ID, error) {
+	if !f.has(id.GetId()) {
+		return nil, errors.ErrObjectIDNotFound(id.GetId())
+	}
+	return id, nil
+}
+
+func (f *fakeAgent) Insert(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.vectors[vec.GetId().GetId()] = vec.GetVector()
+	return new(payload.Common_Error), nil
+}
+
+func (f *fakeAgent) has(uuid string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.vectors[uuid]
+	return ok
+}
+
+func TestHealAfterAgentLoss(t *testing.T) {
+	ctx := context.Background()
+	errgroup.Init(ctx)
+	log.Init(log.DefaultGlg())
+
+	agents := []*fakeAgent{
+		{vectors: make(map[string][]float64)},
+		{vectors: make(map[string][]float64)},
+		{vectors: make(map[string][]float64)},
+	}
+	addrs, stop, err := grpctest.NewAgentServers(agents[0], agents[1], agents[2])
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+
+	// place every uuid on its 2 owners of the 3 agents as the gateway does.
+	const n = 100
+	p := placement.New(placement.WithReplicas(2))
+	p.Update(addrs)
+	for i := 0; i < n; i++ {
+		uuid := "uuid-" + strconv.Itoa(i)
+		for _, owner := range p.Owners(uuid) {
+			for j, addr := range addrs {
+				if addr == owner {
+					agents[j].vectors[uuid] = []float64{float64(i), 1}
+				}
+			}
+		}
+	}
+
+	r, err := NewReplicator(WithReplicatorReplicas(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestHealAfterAgentLoss(%v)", err)
+	}
+	defer r.Close()
+
+	// the third agent is lost, the uuids it owned have a single replica left.
+	var lost uint64
+	for i := 0; i < n; i++ {
+		uuid := "uuid-" + strconv.Itoa(i)
+		if !agents[0].has(uuid) || !agents[1].has(uuid) {
+			lost++
+		}
+	}
+	if lost == 0 {
+		t.Fatal("TestHealAfterAgentLoss: wanted the uuids owned by the lost agent")
+	}
+
+	if err := r.(*replicator).update(ctx, addrs[:2]); err != nil {
+		t.Fatalf("Unexpected error: TestHealAfterAgentLoss(%v)", err)
+	}
+	if err := r.(*replicator).heal(ctx); err != nil {
+		t.Fatalf("Unexpected error: TestHealAfterAgentLoss(%v)", err)
+	}
+
+	for i := 0; i < n; i++ {
+		uuid := "uuid-" + strconv.Itoa(i)
+		if !agents[0].has(uuid) || !agents[1].has(uuid) {
+			t.Errorf("TestHealAfterAgentLoss: %s is under-replicated after heal", uuid)
+		}
+		vec, _ := agents[0].GetObject(ctx, &payload.Object_ID{Id: uuid})
+		if wants := []float64{float64(i), 1}; !reflect.DeepEqual(vec.GetVector(), wants) {
+			t.Errorf("TestHealAfterAgentLoss: %v, wanted: %v", vec.GetVector(), wants)
+		}
+	}
+
+	st := r.Status()
+	wants := &payload.Replication_Status{
+		Agents:          2,
+		Objects:         n,
+		UnderReplicated: lost,
+		Healed:          lost,
+		Runs:            1,
+	}
+	st.StartedAt, st.FinishedAt = 0, 0
+	if !reflect.DeepEqual(st, wants) {
+		t.Errorf("TestHealAfterAgentLoss: %v, wanted: %v", st, wants)
+	}
+
+	// the second check finds nothing to heal.
+	if err := r.(*replicator).heal(ctx); err != nil {
+		t.Fatalf("Unexpected error: TestHealAfterAgentLoss(%v)", err)
+	}
+	if st := r.Status(); st.GetUnderReplicated() != 0 || st.GetRuns() != 2 {
+		t.Errorf("TestHealAfterAgentLoss: %v, wanted no under-replicated uuids", st)
+	}
+}
+
+func TestHealSkipsStaleCopies(t *testing.T) {
+	ctx := context.Background()
+	errgroup.Init(ctx)
+	log.Init(log.DefaultGlg())
+
+	agents := []*fakeAgent{
+		{vectors: make(map[string][]float64)},
+		{vectors: make(map[string][]float64)},
+		{vectors: make(map[string][]float64)},
+	}
+	addrs, stop, err := grpctest.NewAgentServers(agents[0], agents[1], agents[2])
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+
+	r, err := NewReplicator(WithReplicatorReplicas(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestHealSkipsStaleCopies(%v)", err)
+	}
+	defer r.Close()
+	if err := r.(*replicator).update(ctx, addrs); err != nil {
+		t.Fatalf("Unexpected error: TestHealSkipsStaleCopies(%v)", err)
+	}
+
+	// the uuid removed through its owners is left on the agent not owning it.
+	const uuid = "stale"
+	owners := r.(*replicator).placement.Owners(uuid)
+	var stale *fakeAgent
+	for i, addr := range addrs {
+		if !contains(owners, addr) {
+			stale = agents[i]
+		}
+	}
+	stale.vectors[uuid] = []float64{1, 1}
+
+	if err := r.(*replicator).heal(ctx); err != nil {
+		t.Fatalf("Unexpected error: TestHealSkipsStaleCopies(%v)", err)
+	}
+	for i, addr := range addrs {
+		if contains(owners, addr) && agents[i].has(uuid) {
+			t.Errorf("TestHealSkipsStaleCopies: %s is copied back to the owner %s", uuid, addr)
+		}
+	}
+	if st := r.Status(); st.GetUnderReplicated() != 0 || st.GetHealed() != 0 {
+		t.Errorf("TestHealSkipsStaleCopies: %v, wanted nothing healed", st)
+	}
+}
+
+func TestHealWithoutAgents(t *testing.T) {
+	ctx := context.Background()
+	errgroup.Init(ctx)
+	log.Init(log.DefaultGlg())
+
+	r, err := NewReplicator()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestHealWithoutAgents(%v)", err)
+	}
+	defer r.Close()
+
+	if err := r.(*replicator).heal(ctx); err != errors.ErrReplicationAgentsNotFound {
+		t.Errorf("TestHealWithoutAgents: %v, wanted: %v", err, errors.ErrReplicationAgentsNotFound)
+	}
+	if st := r.Heal(); st.GetRuns() != 1 || st.GetError() != errors.ErrReplicationAgentsNotFound.Error() {
+		t.Errorf("TestHealWithoutAgents: %v, wanted the failed run", st)
+	}
+}
@@ -2,28 +2,40 @@ package usecase
 
 import (
 	"context"
+	"expvar"
 
+	"github.com/vdaas/vald/internal/errgroup"
 	"github.com/vdaas/vald/internal/runner"
-	"github.com/vdaas/vald/pkg/agent/ngt/config"
-	"github.com/vdaas/vald/pkg/agent/ngt/handler/grpc"
-	"github.com/vdaas/vald/pkg/agent/ngt/handler/rest"
-	"github.com/vdaas/vald/pkg/agent/ngt/router"
-	"github.com/vdaas/vald/pkg/agent/ngt/service"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/pkg/manager/replication/config"
+	"github.com/vdaas/vald/pkg/manager/replication/handler/grpc"
+	"github.com/vdaas/vald/pkg/manager/replication/handler/rest"
+	"github.com/vdaas/vald/pkg/manager/replication/router"
+	"github.com/vdaas/vald/pkg/manager/replication/service"
 )
 
 type Runner runner.Runner
 
 type run struct {
-	cfg    *config.Data
-	server service.Server
+	cfg        *config.Data
+	server     service.Server
+	replicator service.Replicator
 }
 
 func New(cfg *config.Data) (Runner, error) {
-	ngt, err := service.NewNGT(cfg.NGT)
+	rep, err := service.NewReplicator(
+		service.WithReplicatorDiscovererAddr(cfg.Replication.DiscovererAddr),
+		service.WithReplicatorAgentPort(cfg.Replication.AgentPort),
+		service.WithReplicatorIndex(cfg.Replication.Index),
+		service.WithReplicatorCheckDuration(cfg.Replication.CheckDuration),
+		service.WithReplicatorVirtualNodes(cfg.Replication.VirtualNodes),
+		service.WithReplicatorReplicas(cfg.Replication.Replicas),
+		service.WithReplicatorConcurrency(cfg.Replication.Concurrency),
+	)
 	if err != nil {
 		return nil, err
 	}
-	g := grpc.New(grpc.WithNGT(ngt))
+	g := grpc.New(grpc.WithReplicator(rep))
 
 	srv, err := service.NewServer(
 		service.WithConfig(cfg.Server),
@@ -31,7 +43,7 @@ func New(cfg *config.Data) (Runner, error) {
 			router.New(
 				router.WithHandler(
 					rest.New(
-						rest.WithAgent(g),
+						rest.WithReplication(g),
 					),
 				),
 			),
@@ -41,12 +53,19 @@ func New(cfg *config.Data) (Runner, error) {
 	)
 
 	if err != nil {
+		rep.Close()
 		return nil, err
 	}
 
+	// the status is exported to the metrics servers through expvar
+	expvar.Publish("replication", expvar.Func(func() interface{} {
+		return rep.Status()
+	}))
+
 	return &run{
-		cfg:    cfg,
-		server: srv,
+		cfg:        cfg,
+		server:     srv,
+		replicator: rep,
 	}, nil
 }
 
@@ -55,7 +74,36 @@ func (r *run) PreStart() error {
 }
 
 func (r *run) Start(ctx context.Context) <-chan error {
-	return r.server.ListenAndServe(ctx)
+	ech := make(chan error)
+	sech := r.server.ListenAndServe(ctx)
+	rech := r.replicator.Start(ctx)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		var ok bool
+		for sech != nil || rech != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err, ok = <-sech:
+				if !ok {
+					sech = nil
+				}
+			case err, ok = <-rech:
+				if !ok {
+					rech = nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		return nil
+	}))
+	return ech
 }
 
 func (r *run) PreStop() error {
@@ -63,5 +111,9 @@ func (r *run) PreStop() error {
 }
 
 func (r *run) Stop(ctx context.Context) error {
-	return r.server.Shutdown(ctx)
+	err := r.server.Shutdown(ctx)
+	if cerr := r.replicator.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
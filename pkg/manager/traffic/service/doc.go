@@ -20,10 +20,5 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
 
-// Package model defines object structure
-package model
-
-type Distance struct {
-	ID       string
-	Distance float32
-}
+// Package service manages the main logic of server.
+package service
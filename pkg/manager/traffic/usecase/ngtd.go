@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/traffic"
+	"github.com/vdaas/vald/pkg/manager/traffic/config"
+	"github.com/vdaas/vald/pkg/manager/traffic/handler/grpc"
+	"github.com/vdaas/vald/pkg/manager/traffic/handler/rest"
+	"github.com/vdaas/vald/pkg/manager/traffic/router"
+	"github.com/vdaas/vald/pkg/manager/traffic/service"
+)
+
+type Runner runner.Runner
+
+type run struct {
+	cfg    *config.Data
+	server service.Server
+}
+
+func New(cfg *config.Data) (Runner, error) {
+	topts, err := cfg.Traffic.Opts()
+	if err != nil {
+		return nil, err
+	}
+	g := grpc.New(grpc.WithLimiter(traffic.New(topts...)))
+
+	srv, err := service.NewServer(
+		service.WithConfig(cfg.Server),
+		service.WithREST(
+			router.New(
+				router.WithHandler(
+					rest.New(
+						rest.WithTraffic(g),
+					),
+				),
+			),
+		),
+		service.WithGRPC(g),
+		// TODO add GraphQL handler
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &run{
+		cfg:    cfg,
+		server: srv,
+	}, nil
+}
+
+func (r *run) PreStart() error {
+	return nil
+}
+
+func (r *run) Start(ctx context.Context) <-chan error {
+	return r.server.ListenAndServe(ctx)
+}
+
+func (r *run) PreStop() error {
+	return nil
+}
+
+func (r *run) Stop(ctx context.Context) error {
+	return r.server.Shutdown(ctx)
+}
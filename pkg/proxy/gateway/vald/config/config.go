@@ -36,8 +36,8 @@ type Data struct {
 	// Server represent all server configurations
 	Server *config.Servers `json:"server_config" yaml:"server_config"`
 
-	// NGT represent ngt core configuration
-	NGT *config.NGT `json:"ngt" yaml:"ngt"`
+	// Gateway represent the agent discovery and proxy configuration
+	Gateway *config.Gateway `json:"gateway" yaml:"gateway"`
 }
 
 func NewConfig(path string) (cfg *Data, err error) {
@@ -50,8 +50,10 @@ func NewConfig(path string) (cfg *Data, err error) {
 	if cfg.Server != nil {
 		cfg.Server = cfg.Server.Bind()
 	}
-	if cfg.NGT != nil {
-		cfg.NGT = cfg.NGT.Bind()
+	if cfg.Gateway != nil {
+		cfg.Gateway = cfg.Gateway.Bind()
+	} else {
+		cfg.Gateway = new(config.Gateway)
 	}
 
 	return cfg, nil
@@ -25,20 +25,24 @@ package grpc
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/vdaas/vald/apis/grpc/agent"
 	"github.com/vdaas/vald/apis/grpc/payload"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/net/grpc"
-	"github.com/vdaas/vald/pkg/proxy/gateway/vald/model"
 	"github.com/vdaas/vald/pkg/proxy/gateway/vald/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Server agent.AgentServer
 
 type server struct {
-	ngt service.NGT
+	// UnimplementedAgentServer answers the agent only RPCs with codes.Unimplemented
+	agent.UnimplementedAgentServer
+	gateway service.Gateway
 }
 
 func New(opts ...Option) Server {
@@ -50,59 +54,27 @@ func New(opts ...Option) Server {
 	return s
 }
 
-func (s *server) Exists(ctx context.Context, oid *payload.Object_ID) (*payload.Object_ID, error) {
-	id, ok := s.ngt.Exists(oid.GetId())
-	if !ok {
+func (s *server) Exists(ctx context.Context, oid *payload.Object_ID) (id *payload.Object_ID, err error) {
+	err = s.gateway.DoAny(ctx, s.gateway.Owners(oid.GetId()), func(ctx context.Context, addr string, ac agent.AgentClient) (err error) {
+		id, err = ac.Exists(ctx, oid)
+		return err
+	})
+	if err != nil {
 		return nil, errors.ErrObjectIDNotFound(oid.GetId())
 	}
-	return &payload.Object_ID{
-		Id: id,
-	}, nil
+	return id, nil
 }
 
 func (s *server) Search(ctx context.Context, req *payload.Search_Request) (*payload.Search_Response, error) {
-	return toSearchResponse(
-		s.ngt.Search(
-			req.GetVector().GetVector(),
-			req.GetConfig().GetNum(),
-			req.GetConfig().GetEpsilon(),
-			req.GetConfig().GetRadius()))
+	return s.gateway.Search(ctx, req)
 }
 
 func (s *server) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error) {
-	return toSearchResponse(
-		s.ngt.SearchByID(
-			req.GetId().GetId(),
-			req.GetConfig().GetNum(),
-			req.GetConfig().GetEpsilon(),
-			req.GetConfig().GetRadius()))
+	return s.gateway.SearchByID(ctx, req)
 }
 
-func toSearchResponse(dists []model.Distance, err error) (*payload.Search_Response, error) {
-	if err != nil {
-		return &payload.Search_Response{
-			Error: &payload.Common_Error{
-				Msg:       err.Error(),
-				Timestamp: time.Now().UnixNano(),
-			},
-		}, err
-	}
-
-	res := &payload.Search_Response{
-		Results: make([]*payload.Object_Distance, 0, len(dists)),
-	}
-
-	for _, dist := range dists {
-		// res.Results = append(res.Results, (*payload.Object_Distance)(unsafe.Pointer(&dist)))
-		res.Results = append(res.Results, &payload.Object_Distance{
-			Id: &payload.Object_ID{
-				Id: dist.ID,
-			},
-			Distance: dist.Distance,
-		})
-	}
-
-	return res, nil
+func (s *server) MultiSearch(ctx context.Context, req *payload.Search_MultiRequest) (*payload.Search_Responses, error) {
+	return s.gateway.MultiSearch(ctx, req)
 }
 
 func (s *server) StreamSearch(stream agent.Agent_StreamSearchServer) error {
@@ -118,12 +90,16 @@ func (s *server) StreamSearchByID(stream agent.Agent_StreamSearchByIDServer) err
 }
 
 func (s *server) Insert(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
-	err := s.ngt.Insert(vec.GetId().GetId(), vec.GetVector())
+	err := s.doOwners(ctx, vec.GetId().GetId(), func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		_, err := ac.Insert(ctx, vec)
+		// the owners inserted by the partially failed request before its retry already have the same vector
+		if status.Code(err) == codes.AlreadyExists && stored(ctx, ac, vec) {
+			return nil
+		}
+		return err
+	})
 	if err != nil {
-		return &payload.Common_Error{
-			Msg:       err.Error(),
-			Timestamp: time.Now().UnixNano(),
-		}, err
+		return toError(vec.GetId().GetId(), err), err
 	}
 	return nil, nil
 }
@@ -134,25 +110,67 @@ func (s *server) StreamInsert(stream agent.Agent_StreamInsertServer) error {
 	})
 }
 
-func (s *server) MultiInsert(ctx context.Context, vecs *payload.Object_Vectors) (res *payload.Common_Errors, err error) {
-	res = new(payload.Common_Errors)
+// MultiInsert groups the vectors by their owners and passes each group to its agent at once to keep its bulk insert path.
+func (s *server) MultiInsert(ctx context.Context, vecs *payload.Object_Vectors) (*payload.Common_Errors, error) {
+	res := new(payload.Common_Errors)
+	batches := make(map[string]*payload.Object_Vectors)
+	var addrs []string
 	for _, vec := range vecs.GetVectors() {
-		r, ierr := s.Insert(ctx, vec)
-		if ierr != nil {
-			err = errors.Wrap(err, ierr.Error())
-			res.Errors = append(res.Errors, r)
+		owners := s.gateway.Owners(vec.GetId().GetId())
+		if len(owners) == 0 {
+			res.Errors = append(res.Errors, toError(vec.GetId().GetId(), errors.ErrAgentsNotFound))
+			continue
+		}
+		for _, addr := range owners {
+			b, ok := batches[addr]
+			if !ok {
+				b = new(payload.Object_Vectors)
+				batches[addr] = b
+				addrs = append(addrs, addr)
+			}
+			b.Vectors = append(b.Vectors, vec)
 		}
 	}
-	return
+
+	var mu sync.Mutex
+	errs := s.gateway.DoAll(ctx, addrs, func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		r, err := ac.MultiInsert(ctx, batches[addr])
+		if err != nil {
+			return err
+		}
+		byID := make(map[string]*payload.Object_Vector, len(batches[addr].GetVectors()))
+		for _, vec := range batches[addr].GetVectors() {
+			byID[vec.GetId().GetId()] = vec
+		}
+		failed := make([]*payload.Common_Error, 0, len(r.GetErrors()))
+		for _, e := range r.GetErrors() {
+			// the agent reports no code for each uuid, so the uuid stored with the same vector is taken as inserted as Insert does
+			if vec, ok := byID[e.GetId()]; ok && stored(ctx, ac, vec) {
+				continue
+			}
+			failed = append(failed, toError(e.GetId(), errors.ErrAgentRequestFailed(errors.New(e.GetMsg()), addr)))
+		}
+		mu.Lock()
+		res.Errors = append(res.Errors, failed...)
+		mu.Unlock()
+		return nil
+	})
+	for addr, err := range errs {
+		for _, vec := range batches[addr].GetVectors() {
+			res.Errors = append(res.Errors, toError(vec.GetId().GetId(), errors.ErrAgentRequestFailed(err, addr)))
+		}
+	}
+	return res, nil
 }
 
+// Update updates the vector on the agents owning the uuid.
 func (s *server) Update(ctx context.Context, vec *payload.Object_Vector) (*payload.Common_Error, error) {
-	err := s.ngt.Update(vec.GetId().GetId(), vec.GetVector())
+	err := s.doOwners(ctx, vec.GetId().GetId(), func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		_, err := ac.Update(ctx, vec)
+		return err
+	})
 	if err != nil {
-		return &payload.Common_Error{
-			Msg:       err.Error(),
-			Timestamp: time.Now().UnixNano(),
-		}, err
+		return toError(vec.GetId().GetId(), err), err
 	}
 	return nil, nil
 }
@@ -175,13 +193,14 @@ func (s *server) MultiUpdate(ctx context.Context, vecs *payload.Object_Vectors)
 	return
 }
 
+// Remove removes the uuid from the agents owning it.
 func (s *server) Remove(ctx context.Context, id *payload.Object_ID) (*payload.Common_Error, error) {
-	err := s.ngt.Delete(id.GetId())
+	err := s.doOwners(ctx, id.GetId(), func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		_, err := ac.Remove(ctx, id)
+		return err
+	})
 	if err != nil {
-		return &payload.Common_Error{
-			Msg:       err.Error(),
-			Timestamp: time.Now().UnixNano(),
-		}, err
+		return toError(id.GetId(), err), err
 	}
 	return nil, nil
 }
@@ -205,17 +224,7 @@ func (s *server) MultiRemove(ctx context.Context, ids *payload.Object_IDs) (res
 }
 
 func (s *server) GetObject(ctx context.Context, id *payload.Object_ID) (*payload.Object_Vector, error) {
-	vec, err := s.ngt.GetObject(id.GetId())
-	if err != nil {
-		return nil, err
-	}
-	return &payload.Object_Vector{
-		Id: &payload.Object_ID{
-			Id: id.GetId(),
-		},
-		Vector: vec,
-	}, nil
-
+	return s.gateway.GetObject(ctx, id)
 }
 
 func (s *server) StreamGetObject(stream agent.Agent_StreamGetObjectServer) error {
@@ -225,9 +234,78 @@ func (s *server) StreamGetObject(stream agent.Agent_StreamGetObjectServer) error
 }
 
 func (s *server) CreateIndex(ctx context.Context, c *payload.Controll_CreateIndexRequest) (*payload.Common_Empty, error) {
-	return nil, s.ngt.CreateIndex(c.GetPoolSize())
+	return nil, s.broadCastAll(ctx, func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		_, err := ac.CreateIndex(ctx, c)
+		return err
+	})
+}
+
+func (s *server) SaveIndex(ctx context.Context, req *payload.Controll_IndexRequest) (*payload.Common_Empty, error) {
+	return nil, s.broadCastAll(ctx, func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		_, err := ac.SaveIndex(ctx, req)
+		return err
+	})
 }
 
-func (s *server) SaveIndex(context.Context, *payload.Common_Empty) (*payload.Common_Empty, error) {
-	return nil, s.ngt.SaveIndex()
+// doOwners calls f on every agent owning the uuid and fails when f fails on any of them.
+func (s *server) doOwners(ctx context.Context, uuid string, f func(ctx context.Context, addr string, ac agent.AgentClient) error) error {
+	owners := s.gateway.Owners(uuid)
+	if len(owners) == 0 {
+		return errors.ErrAgentsNotFound
+	}
+	return joinErrors(s.gateway.DoAll(ctx, owners, f))
+}
+
+// broadCastAll calls f on every agent and fails when f fails on any agent.
+func (s *server) broadCastAll(ctx context.Context, f func(ctx context.Context, addr string, ac agent.AgentClient) error) error {
+	return joinErrors(s.gateway.BroadCast(ctx, f))
+}
+
+// stored reports whether the agent already stores the vector of the request under its uuid.
+// The vectors are compared in float32, which the index stores.
+func stored(ctx context.Context, ac agent.AgentClient, vec *payload.Object_Vector) bool {
+	obj, err := ac.GetObject(ctx, &payload.Object_ID{
+		Id:    vec.GetId().GetId(),
+		Index: vec.GetIndex(),
+	})
+	if err != nil {
+		return false
+	}
+	got := obj.GetVector()
+	if fv := vec.GetFloat32Vector(); len(vec.GetVector()) == 0 && len(fv) != 0 {
+		if len(got) != len(fv) {
+			return false
+		}
+		for i, v := range fv {
+			if float32(got[i]) != v {
+				return false
+			}
+		}
+		return true
+	}
+	want := vec.GetVector()
+	if len(got) != len(want) {
+		return false
+	}
+	for i, v := range want {
+		if float32(got[i]) != float32(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func joinErrors(errs map[string]error) (err error) {
+	for addr, aerr := range errs {
+		err = errors.Wrap(err, errors.ErrAgentRequestFailed(aerr, addr).Error())
+	}
+	return err
+}
+
+func toError(uuid string, err error) *payload.Common_Error {
+	return &payload.Common_Error{
+		Id:        uuid,
+		Msg:       err.Error(),
+		Timestamp: time.Now().UnixNano(),
+	}
 }
@@ -0,0 +1,157 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package grpc provides grpc server logic
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/pkg/proxy/gateway/vald/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAgentClient struct {
+	agent.AgentClient
+	err     error
+	vectors map[string][]float64
+}
+
+func (f *fakeAgentClient) Insert(ctx context.Context, vec *payload.Object_Vector, opts ...grpc.CallOption) (*payload.Common_Error, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if _, ok := f.vectors[vec.GetId().GetId()]; ok {
+		return nil, status.Error(codes.AlreadyExists, "uuid already exists")
+	}
+	f.vectors[vec.GetId().GetId()] = vec.GetVector()
+	return nil, nil
+}
+
+func (f *fakeAgentClient) MultiInsert(ctx context.Context, vecs *payload.Object_Vectors, opts ...grpc.CallOption) (*payload.Common_Errors, error) {
+	res := new(payload.Common_Errors)
+	for _, vec := range vecs.GetVectors() {
+		if _, err := f.Insert(ctx, vec); err != nil {
+			res.Errors = append(res.Errors, &payload.Common_Error{
+				Id:  vec.GetId().GetId(),
+				Msg: err.Error(),
+			})
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeAgentClient) GetObject(ctx context.Context, id *payload.Object_ID, opts ...grpc.CallOption) (*payload.Object_Vector, error) {
+	vec, ok := f.vectors[id.GetId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "uuid not found")
+	}
+	return &payload.Object_Vector{
+		Id:     id,
+		Vector: vec,
+	}, nil
+}
+
+type fakeGateway struct {
+	service.Gateway
+	agents map[string]*fakeAgentClient
+}
+
+func (f *fakeGateway) Owners(uuid string) []string {
+	owners := make([]string, 0, len(f.agents))
+	for addr := range f.agents {
+		owners = append(owners, addr)
+	}
+	return owners
+}
+
+func (f *fakeGateway) DoAll(ctx context.Context, addrs []string, fn func(ctx context.Context, addr string, ac agent.AgentClient) error) map[string]error {
+	errs := make(map[string]error)
+	for _, addr := range addrs {
+		if err := fn(ctx, addr, f.agents[addr]); err != nil {
+			errs[addr] = err
+		}
+	}
+	return errs
+}
+
+func newFakeGateway() *fakeGateway {
+	return &fakeGateway{
+		agents: map[string]*fakeAgentClient{
+			"agent-0": {vectors: make(map[string][]float64)},
+			"agent-1": {vectors: make(map[string][]float64)},
+		},
+	}
+}
+
+func TestInsertRetryAfterPartialFailure(t *testing.T) {
+	g := newFakeGateway()
+	g.agents["agent-1"].err = errors.New("unavailable")
+	s := New(WithGateway(g))
+	vec := &payload.Object_Vector{
+		Id:     &payload.Object_ID{Id: "uuid"},
+		Vector: []float64{0.1, 0.2},
+	}
+
+	if _, err := s.Insert(context.Background(), vec); err == nil {
+		t.Fatal("TestInsertRetryAfterPartialFailure: nil, wanted the error of agent-1")
+	}
+
+	g.agents["agent-1"].err = nil
+	if _, err := s.Insert(context.Background(), vec); err != nil {
+		t.Errorf("Unexpected error: TestInsertRetryAfterPartialFailure(%v)", err)
+	}
+
+	conflict := &payload.Object_Vector{
+		Id:     &payload.Object_ID{Id: "uuid"},
+		Vector: []float64{0.3, 0.4},
+	}
+	if _, err := s.Insert(context.Background(), conflict); err == nil {
+		t.Error("TestInsertRetryAfterPartialFailure: nil, wanted the conflict of the uuid stored with another vector")
+	}
+}
+
+func TestMultiInsertRetryAfterPartialFailure(t *testing.T) {
+	g := newFakeGateway()
+	g.agents["agent-0"].vectors["a"] = []float64{0.1, 0.2}
+	g.agents["agent-0"].vectors["b"] = []float64{0.9, 0.9}
+	s := New(WithGateway(g))
+
+	res, err := s.MultiInsert(context.Background(), &payload.Object_Vectors{
+		Vectors: []*payload.Object_Vector{
+			{Id: &payload.Object_ID{Id: "a"}, Vector: []float64{0.1, 0.2}},
+			{Id: &payload.Object_ID{Id: "b"}, Vector: []float64{0.3, 0.4}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: TestMultiInsertRetryAfterPartialFailure(%v)", err)
+	}
+	if len(res.GetErrors()) != 1 || res.GetErrors()[0].GetId() != "b" {
+		t.Errorf("TestMultiInsertRetryAfterPartialFailure: %v, wanted the conflict of b only", res.GetErrors())
+	}
+}
@@ -31,8 +31,8 @@ var (
 	defaultOpts = []Option{}
 )
 
-func WithNGT(n service.NGT) Option {
+func WithGateway(g service.Gateway) Option {
 	return func(s *server) {
-		s.ngt = n
+		s.gateway = g
 	}
 }
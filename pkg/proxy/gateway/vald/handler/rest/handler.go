@@ -39,6 +39,7 @@ type Handler interface {
 	Exists(w http.ResponseWriter, r *http.Request) error
 	Search(w http.ResponseWriter, r *http.Request) error
 	SearchByID(w http.ResponseWriter, r *http.Request) error
+	MultiSearch(w http.ResponseWriter, r *http.Request) error
 	Insert(w http.ResponseWriter, r *http.Request) error
 	MultiInsert(w http.ResponseWriter, r *http.Request) error
 	Update(w http.ResponseWriter, r *http.Request) error
@@ -87,6 +88,25 @@ func (h *handler) Search(w http.ResponseWriter, r *http.Request) (err error) {
 	return nil
 }
 
+func (h *handler) MultiSearch(w http.ResponseWriter, r *http.Request) (err error) {
+	var req *payload.Search_MultiRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	res, err := h.agent.MultiSearch(r.Context(), req)
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (h *handler) SearchByID(w http.ResponseWriter, r *http.Request) (err error) {
 	var req *payload.Search_IDRequest
 	err = json.NewDecoder(r.Body).Decode(&req)
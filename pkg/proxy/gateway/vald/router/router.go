@@ -64,6 +64,14 @@ func New(opts ...Option) http.Handler {
 				"/search",
 				h.Search,
 			},
+			{
+				"Multiple Search",
+				[]string{
+					http.MethodPost,
+				},
+				"/search/multi",
+				h.MultiSearch,
+			},
 			{
 				"Search By ID",
 				[]string{
@@ -0,0 +1,511 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/discoverer"
+	"github.com/vdaas/vald/apis/grpc/meta_manager"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/membership"
+	igrpc "github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/placement"
+	"github.com/vdaas/vald/internal/safety"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Gateway scatters the requests to the agents listed by the discoverer and gathers their results.
+type Gateway interface {
+	Start(ctx context.Context) <-chan error
+	Search(ctx context.Context, req *payload.Search_Request) (*payload.Search_Response, error)
+	SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error)
+	MultiSearch(ctx context.Context, req *payload.Search_MultiRequest) (*payload.Search_Responses, error)
+	GetObject(ctx context.Context, id *payload.Object_ID) (*payload.Object_Vector, error)
+	// BroadCast calls f for every agent concurrently and returns the errors by agent address.
+	BroadCast(ctx context.Context, f func(ctx context.Context, addr string, ac agent.AgentClient) error) map[string]error
+	// Owners returns the addresses of the agents owning the uuid, the primary one first.
+	Owners(uuid string) []string
+	// DoAll calls f for the agents of addrs concurrently and returns the errors by agent address.
+	DoAll(ctx context.Context, addrs []string, f func(ctx context.Context, addr string, ac agent.AgentClient) error) map[string]error
+	// DoAny calls f for the agents of addrs in order until it succeeds.
+	DoAny(ctx context.Context, addrs []string, f func(ctx context.Context, addr string, ac agent.AgentClient) error) error
+	Close() error
+}
+
+type gateway struct {
+	pool              igrpc.Pool
+	placement         placement.Placement
+	placementOpts     []placement.Option
+	dialOpts          []grpc.DialOption
+	discovererAddr    string
+	discoverer        *grpc.ClientConn
+	discoveryDuration time.Duration
+	agentPort         int
+	metaAddr          string
+	meta              *grpc.ClientConn
+}
+
+const (
+	// searchDeadlineRatio is the share of the remaining request time given to the agents,
+	// the rest is kept to merge and answer the partial results before the request deadline.
+	searchDeadlineRatio = 0.9
+)
+
+func NewGateway(opts ...GatewayOption) (Gateway, error) {
+	g := new(gateway)
+	for _, opt := range append(defaultGatewayOpts, opts...) {
+		opt(g)
+	}
+	g.pool = igrpc.NewPool(g.dialOpts...)
+	g.placement = placement.New(g.placementOpts...)
+
+	if g.discovererAddr != "" {
+		conn, err := grpc.Dial(g.discovererAddr, g.dialOpts...)
+		if err != nil {
+			return nil, errors.ErrGRPCDialFailed(err, g.discovererAddr)
+		}
+		g.discoverer = conn
+	}
+
+	if g.metaAddr != "" {
+		conn, err := grpc.Dial(g.metaAddr, g.dialOpts...)
+		if err != nil {
+			if g.discoverer != nil {
+				g.discoverer.Close()
+			}
+			return nil, errors.ErrGRPCDialFailed(err, g.metaAddr)
+		}
+		g.meta = conn
+	}
+	return g, nil
+}
+
+// Start follows the agent membership stream of the discoverer, and polls the discoverer every discovery duration
+// when it does not support the stream. The broken stream is reconnected after the discovery duration.
+func (g *gateway) Start(ctx context.Context) <-chan error {
+	ech := make(chan error, 1)
+	if g.discoverer == nil {
+		close(ech)
+		return ech
+	}
+
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+
+		t := time.NewTicker(g.discoveryDuration)
+		defer t.Stop()
+		for {
+			err = g.watch(ctx)
+			if status.Code(err) == codes.Unimplemented {
+				err = g.discover(ctx)
+			}
+			if err != nil && ctx.Err() == nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+		}
+	}))
+	return ech
+}
+
+func (g *gateway) discover(ctx context.Context) error {
+	addrs, err := membership.Discover(ctx, g.discoverer, g.agentPort)
+	if err != nil {
+		return err
+	}
+	err = g.update(ctx, addrs)
+	if err != nil {
+		return err
+	}
+	log.Debugf("gateway discovered %d agents", len(addrs))
+	return nil
+}
+
+// watch applies the agent events of the discoverer to the connections until the stream breaks.
+func (g *gateway) watch(ctx context.Context) error {
+	stream, err := discoverer.NewDiscovererClient(g.discoverer).Watch(ctx, new(payload.Common_Empty))
+	if err != nil {
+		return err
+	}
+
+	agents := make(map[string]*payload.Info_Agent)
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch ev.GetType() {
+		case payload.Info_AgentEvent_SNAPSHOT:
+			agents = make(map[string]*payload.Info_Agent, len(ev.GetSnapshot().GetAgents()))
+			for _, a := range ev.GetSnapshot().GetAgents() {
+				agents[a.GetIp()] = a
+			}
+		case payload.Info_AgentEvent_ADD, payload.Info_AgentEvent_UPDATE:
+			agents[ev.GetAgent().GetIp()] = ev.GetAgent()
+		case payload.Info_AgentEvent_REMOVE:
+			delete(agents, ev.GetAgent().GetIp())
+		}
+
+		as := make([]*payload.Info_Agent, 0, len(agents))
+		for _, a := range agents {
+			as = append(as, a)
+		}
+		addrs := membership.Addrs(as, g.agentPort)
+		err = g.update(ctx, addrs)
+		if err != nil {
+			return err
+		}
+		log.Debugf("gateway watched %s event, %d agents", ev.GetType(), len(addrs))
+	}
+}
+
+// update replaces the agent connections and their placement on the hash ring.
+func (g *gateway) update(ctx context.Context, addrs []string) error {
+	err := g.pool.Update(ctx, addrs)
+	if err != nil {
+		return err
+	}
+	g.placement.Update(g.pool.Addrs())
+	return nil
+}
+
+func (g *gateway) BroadCast(ctx context.Context, f func(ctx context.Context, addr string, ac agent.AgentClient) error) map[string]error {
+	return g.DoAll(ctx, g.pool.Addrs(), f)
+}
+
+func (g *gateway) Owners(uuid string) []string {
+	return g.placement.Owners(uuid)
+}
+
+func (g *gateway) DoAll(ctx context.Context, addrs []string, f func(ctx context.Context, addr string, ac agent.AgentClient) error) map[string]error {
+	var (
+		mu    sync.Mutex
+		errs  = make(map[string]error)
+		_, eg = errgroup.New(ctx)
+	)
+	for _, addr := range addrs {
+		addr := addr
+		conn, ok := g.pool.Get(addr)
+		if !ok {
+			mu.Lock()
+			errs[addr] = errors.ErrAgentsNotFound
+			mu.Unlock()
+			continue
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			err := f(ctx, addr, agent.NewAgentClient(conn))
+			if err != nil {
+				mu.Lock()
+				errs[addr] = err
+				mu.Unlock()
+			}
+			return nil
+		}))
+	}
+	eg.Wait()
+	return errs
+}
+
+func (g *gateway) DoAny(ctx context.Context, addrs []string, f func(ctx context.Context, addr string, ac agent.AgentClient) error) (err error) {
+	if len(addrs) == 0 {
+		return errors.ErrAgentsNotFound
+	}
+	for _, addr := range addrs {
+		conn, ok := g.pool.Get(addr)
+		if !ok {
+			err = errors.Wrap(err, errors.ErrAgentRequestFailed(errors.ErrAgentsNotFound, addr).Error())
+			continue
+		}
+		aerr := f(ctx, addr, agent.NewAgentClient(conn))
+		if aerr == nil {
+			return nil
+		}
+		err = errors.Wrap(err, errors.ErrAgentRequestFailed(aerr, addr).Error())
+	}
+	return err
+}
+
+func (g *gateway) Search(ctx context.Context, req *payload.Search_Request) (*payload.Search_Response, error) {
+	res, err := g.search(ctx, req.GetConfig().GetNum(),
+		func(ctx context.Context, ac agent.AgentClient) (*payload.Search_Response, error) {
+			return ac.Search(ctx, req)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if req.GetConfig().GetWithMetadata() {
+		if err := g.joinMeta(ctx, res); err != nil {
+			res.Errors = append(res.Errors, toMetaError(g.metaAddr, err))
+		}
+	}
+	return res, nil
+}
+
+// SearchByID searches the vector of the uuid on every agent, as the agents not holding the uuid cannot search by it.
+func (g *gateway) SearchByID(ctx context.Context, req *payload.Search_IDRequest) (*payload.Search_Response, error) {
+	vec, err := g.GetObject(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return g.Search(ctx, &payload.Search_Request{
+		Vector: vec,
+		Config: req.GetConfig(),
+	})
+}
+
+func (g *gateway) search(ctx context.Context, num uint32,
+	f func(ctx context.Context, ac agent.AgentClient) (*payload.Search_Response, error)) (*payload.Search_Response, error) {
+	sctx, cancel := searchContext(ctx)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		results []*payload.Object_Distance
+		ok      int
+	)
+	errs := g.BroadCast(sctx, func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		res, err := f(ctx, ac)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		results = append(results, res.GetResults()...)
+		ok++
+		mu.Unlock()
+		return nil
+	})
+	if ok == 0 {
+		return nil, toError(errs)
+	}
+
+	return &payload.Search_Response{
+		Results: merge(results, int(num)),
+		Errors:  toErrors(errs),
+	}, nil
+}
+
+func (g *gateway) MultiSearch(ctx context.Context, req *payload.Search_MultiRequest) (*payload.Search_Responses, error) {
+	sctx, cancel := searchContext(ctx)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		results = make([][]*payload.Object_Distance, len(req.GetVectors()))
+		ok      int
+	)
+	errs := g.BroadCast(sctx, func(ctx context.Context, addr string, ac agent.AgentClient) error {
+		res, err := ac.MultiSearch(ctx, req)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		for i, r := range res.GetResponses() {
+			if i < len(results) {
+				results[i] = append(results[i], r.GetResults()...)
+			}
+		}
+		ok++
+		mu.Unlock()
+		return nil
+	})
+	if ok == 0 {
+		return nil, toError(errs)
+	}
+
+	res := &payload.Search_Responses{
+		Responses: make([]*payload.Search_Response, 0, len(results)),
+	}
+	aerrs := toErrors(errs)
+	for _, r := range results {
+		res.Responses = append(res.Responses, &payload.Search_Response{
+			Results: merge(r, int(req.GetConfig().GetNum())),
+			Errors:  aerrs,
+		})
+	}
+	if req.GetConfig().GetWithMetadata() {
+		if err := g.joinMeta(ctx, res.GetResponses()...); err != nil {
+			merr := toMetaError(g.metaAddr, err)
+			for _, r := range res.GetResponses() {
+				r.Errors = append(r.Errors, merr)
+			}
+		}
+	}
+	return res, nil
+}
+
+// GetObject returns the vector from the first owner of the uuid answering it.
+func (g *gateway) GetObject(ctx context.Context, id *payload.Object_ID) (vec *payload.Object_Vector, err error) {
+	err = g.DoAny(ctx, g.Owners(id.GetId()), func(ctx context.Context, addr string, ac agent.AgentClient) (err error) {
+		vec, err = ac.GetObject(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, errors.ErrObjectIDNotFound(id.GetId())
+	}
+	return vec, nil
+}
+
+// joinMeta sets the metadata of the results of the responses fetched from the meta manager in one batch,
+// the results without metadata are left as they are.
+func (g *gateway) joinMeta(ctx context.Context, responses ...*payload.Search_Response) error {
+	if g.meta == nil {
+		return errors.ErrMetaManagerNotConfigured
+	}
+
+	ids := new(payload.Object_IDs)
+	seen := make(map[string]struct{})
+	for _, res := range responses {
+		for _, r := range res.GetResults() {
+			if _, ok := seen[r.GetId().GetId()]; ok {
+				continue
+			}
+			seen[r.GetId().GetId()] = struct{}{}
+			ids.Ids = append(ids.Ids, &payload.Object_ID{Id: r.GetId().GetId()})
+		}
+	}
+	if len(ids.GetIds()) == 0 {
+		return nil
+	}
+
+	metas, err := meta_manager.NewMetaManagerClient(g.meta).MultiGetMeta(ctx, ids)
+	if err != nil {
+		return err
+	}
+	data := make(map[string]map[string]string, len(metas.GetObjects()))
+	for _, m := range metas.GetObjects() {
+		data[m.GetId()] = m.GetData()
+	}
+	for _, res := range responses {
+		for _, r := range res.GetResults() {
+			if d, ok := data[r.GetId().GetId()]; ok {
+				r.Metadata = d
+			}
+		}
+	}
+	return nil
+}
+
+func (g *gateway) Close() error {
+	if g.discoverer != nil {
+		g.discoverer.Close()
+	}
+	if g.meta != nil {
+		g.meta.Close()
+	}
+	return g.pool.Close()
+}
+
+// searchContext shortens the request deadline so that the results gathered until then are answered in time.
+func searchContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(float64(time.Until(d))*searchDeadlineRatio))
+}
+
+// merge returns the num nearest results of the agents, keeping the nearest one for the uuid found on several agents.
+func merge(results []*payload.Object_Distance, num int) []*payload.Object_Distance {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].GetDistance() < results[j].GetDistance()
+	})
+	if num <= 0 {
+		num = len(results)
+	}
+
+	seen := make(map[string]struct{}, num)
+	merged := results[:0]
+	for _, r := range results {
+		if len(merged) >= num {
+			break
+		}
+		if _, ok := seen[r.GetId().GetId()]; ok {
+			continue
+		}
+		seen[r.GetId().GetId()] = struct{}{}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func toErrors(errs map[string]error) []*payload.Common_Error {
+	if len(errs) == 0 {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	res := make([]*payload.Common_Error, 0, len(errs))
+	for addr, err := range errs {
+		res = append(res, &payload.Common_Error{
+			Id:        addr,
+			Msg:       err.Error(),
+			Timestamp: now,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GetId() < res[j].GetId()
+	})
+	return res
+}
+
+func toMetaError(addr string, err error) *payload.Common_Error {
+	return &payload.Common_Error{
+		Id:        addr,
+		Msg:       errors.ErrMetaJoinFailed(err).Error(),
+		Timestamp: time.Now().UnixNano(),
+	}
+}
+
+// toError returns the error of the call which no agent answered.
+func toError(errs map[string]error) (err error) {
+	if len(errs) == 0 {
+		return errors.ErrAgentsNotFound
+	}
+	for addr, aerr := range errs {
+		err = errors.Wrap(err, errors.ErrAgentRequestFailed(aerr, addr).Error())
+	}
+	return err
+}
@@ -0,0 +1,360 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/agent"
+	"github.com/vdaas/vald/apis/grpc/discoverer"
+	"github.com/vdaas/vald/apis/grpc/meta_manager"
+	"github.com/vdaas/vald/apis/grpc/payload"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc/grpctest"
+	"google.golang.org/grpc"
+)
+
+type fakeAgent struct {
+	agent.UnimplementedAgentServer
+	results []*payload.Object_Distance
+	delay   time.Duration
+}
+
+func (f *fakeAgent) Search(ctx context.Context, req *payload.Search_Request) (*payload.Search_Response, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(f.delay):
+	}
+	return &payload.Search_Response{
+		Results: f.results,
+	}, nil
+}
+
+func distances(ids ...string) []*payload.Object_Distance {
+	res := make([]*payload.Object_Distance, 0, len(ids))
+	for i, id := range ids {
+		res = append(res, &payload.Object_Distance{
+			Id:       &payload.Object_ID{Id: id},
+			Distance: float32(i),
+		})
+	}
+	return res
+}
+
+func TestSearchMergesTopK(t *testing.T) {
+	addrs, stop, err := grpctest.NewAgentServers(
+		&fakeAgent{results: []*payload.Object_Distance{
+			{Id: &payload.Object_ID{Id: "a"}, Distance: 0.1},
+			{Id: &payload.Object_ID{Id: "c"}, Distance: 0.3},
+			{Id: &payload.Object_ID{Id: "e"}, Distance: 0.5},
+		}},
+		&fakeAgent{results: []*payload.Object_Distance{
+			{Id: &payload.Object_ID{Id: "b"}, Distance: 0.2},
+			{Id: &payload.Object_ID{Id: "a"}, Distance: 0.1},
+			{Id: &payload.Object_ID{Id: "d"}, Distance: 0.4},
+		}},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+
+	g, err := NewGateway()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchMergesTopK(%v)", err)
+	}
+	defer g.Close()
+	if err := g.(*gateway).update(context.Background(), addrs); err != nil {
+		t.Fatalf("Unexpected error: TestSearchMergesTopK(%v)", err)
+	}
+
+	res, err := g.Search(context.Background(), &payload.Search_Request{
+		Config: &payload.Search_Config{Num: 4},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchMergesTopK(%v)", err)
+	}
+	var ids []string
+	for _, r := range res.GetResults() {
+		ids = append(ids, r.GetId().GetId())
+	}
+	if wants := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(ids, wants) {
+		t.Errorf("TestSearchMergesTopK: %v, wanted: %v", ids, wants)
+	}
+	if len(res.GetErrors()) != 0 {
+		t.Errorf("TestSearchMergesTopK: unexpected agent errors %v", res.GetErrors())
+	}
+}
+
+func TestSearchReturnsPartialResultsByDeadline(t *testing.T) {
+	addrs, stop, err := grpctest.NewAgentServers(
+		&fakeAgent{results: distances("fast")},
+		&fakeAgent{results: distances("slow"), delay: time.Minute},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+
+	g, err := NewGateway()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchReturnsPartialResultsByDeadline(%v)", err)
+	}
+	defer g.Close()
+	if err := g.(*gateway).update(context.Background(), addrs); err != nil {
+		t.Fatalf("Unexpected error: TestSearchReturnsPartialResultsByDeadline(%v)", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := g.Search(ctx, &payload.Search_Request{
+		Config: &payload.Search_Config{Num: 10},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchReturnsPartialResultsByDeadline(%v)", err)
+	}
+	if ctx.Err() != nil {
+		t.Error("TestSearchReturnsPartialResultsByDeadline: answered after the request deadline")
+	}
+	if len(res.GetResults()) != 1 || res.GetResults()[0].GetId().GetId() != "fast" {
+		t.Errorf("TestSearchReturnsPartialResultsByDeadline: %v, wanted: [fast]", res.GetResults())
+	}
+	if len(res.GetErrors()) != 1 || res.GetErrors()[0].GetId() != addrs[1] {
+		t.Errorf("TestSearchReturnsPartialResultsByDeadline: %v, wanted: the error of %s", res.GetErrors(), addrs[1])
+	}
+}
+
+func TestSearchWithoutAgents(t *testing.T) {
+	g, err := NewGateway()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithoutAgents(%v)", err)
+	}
+	defer g.Close()
+
+	if _, err := g.Search(context.Background(), new(payload.Search_Request)); err == nil {
+		t.Error("TestSearchWithoutAgents: nil, wanted: no agents error")
+	}
+}
+
+type fakeMetaManager struct {
+	meta_manager.UnimplementedMetaManagerServer
+	data  map[string]map[string]string
+	calls int
+}
+
+func (f *fakeMetaManager) MultiGetMeta(ctx context.Context, ids *payload.Object_IDs) (*payload.Meta_Objects, error) {
+	f.calls++
+	res := new(payload.Meta_Objects)
+	for _, id := range ids.GetIds() {
+		if d, ok := f.data[id.GetId()]; ok {
+			res.Objects = append(res.Objects, &payload.Meta_Object{Id: id.GetId(), Data: d})
+		}
+	}
+	return res, nil
+}
+
+func TestSearchWithMetadata(t *testing.T) {
+	addrs, stop, err := grpctest.NewAgentServers(
+		&fakeAgent{results: distances("a", "b")},
+		&fakeAgent{results: distances("c")},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+
+	mm := &fakeMetaManager{
+		data: map[string]map[string]string{
+			"a": {"title": "A"},
+			"c": {"title": "C"},
+		},
+	}
+	srv, err := grpctest.NewServer(func(srv *grpc.Server) {
+		meta_manager.RegisterMetaManagerServer(srv, mm)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: NewServer(%v)", err)
+	}
+	defer srv.Stop()
+
+	g, err := NewGateway(WithGatewayMetaAddr(srv.Addr))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadata(%v)", err)
+	}
+	defer g.Close()
+	if err := g.(*gateway).update(context.Background(), addrs); err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadata(%v)", err)
+	}
+
+	res, err := g.Search(context.Background(), &payload.Search_Request{
+		Config: &payload.Search_Config{Num: 10, WithMetadata: true},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadata(%v)", err)
+	}
+	got := make(map[string]map[string]string)
+	for _, r := range res.GetResults() {
+		got[r.GetId().GetId()] = r.GetMetadata()
+	}
+	wants := map[string]map[string]string{
+		"a": {"title": "A"},
+		"b": nil,
+		"c": {"title": "C"},
+	}
+	if !reflect.DeepEqual(got, wants) {
+		t.Errorf("TestSearchWithMetadata: %v, wanted: %v", got, wants)
+	}
+	if mm.calls != 1 {
+		t.Errorf("TestSearchWithMetadata: %d meta manager calls, wanted: 1", mm.calls)
+	}
+	if len(res.GetErrors()) != 0 {
+		t.Errorf("TestSearchWithMetadata: unexpected errors %v", res.GetErrors())
+	}
+
+	res, err = g.Search(context.Background(), &payload.Search_Request{
+		Config: &payload.Search_Config{Num: 10},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadata(%v)", err)
+	}
+	for _, r := range res.GetResults() {
+		if r.GetMetadata() != nil {
+			t.Errorf("TestSearchWithMetadata: %v, wanted no metadata without with_metadata", r)
+		}
+	}
+	if mm.calls != 1 {
+		t.Errorf("TestSearchWithMetadata: %d meta manager calls, wanted no call without with_metadata", mm.calls)
+	}
+}
+
+func TestSearchWithMetadataWithoutMetaManager(t *testing.T) {
+	addrs, stop, err := grpctest.NewAgentServers(&fakeAgent{results: distances("a")})
+	if err != nil {
+		t.Fatalf("Unexpected error: NewAgentServers(%v)", err)
+	}
+	defer stop()
+
+	g, err := NewGateway()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadataWithoutMetaManager(%v)", err)
+	}
+	defer g.Close()
+	if err := g.(*gateway).update(context.Background(), addrs); err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadataWithoutMetaManager(%v)", err)
+	}
+
+	res, err := g.Search(context.Background(), &payload.Search_Request{
+		Config: &payload.Search_Config{Num: 10, WithMetadata: true},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchWithMetadataWithoutMetaManager(%v)", err)
+	}
+	if len(res.GetResults()) != 1 || len(res.GetErrors()) != 1 {
+		t.Errorf("TestSearchWithMetadataWithoutMetaManager: %v, wanted the results with the join error", res)
+	}
+}
+
+type fakeDiscoverer struct {
+	discoverer.UnimplementedDiscovererServer
+	events []*payload.Info_AgentEvent
+}
+
+func (f *fakeDiscoverer) Watch(_ *payload.Common_Empty, stream discoverer.Discoverer_WatchServer) error {
+	for _, ev := range f.events {
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestStartWatchesDiscoverer(t *testing.T) {
+	log.Init(log.DefaultGlg())
+
+	srv, err := grpctest.NewServer(func(srv *grpc.Server) {
+		discoverer.RegisterDiscovererServer(srv, &fakeDiscoverer{
+			events: []*payload.Info_AgentEvent{
+				{
+					Type: payload.Info_AgentEvent_SNAPSHOT,
+					Snapshot: &payload.Info_Agents{
+						Agents: []*payload.Info_Agent{
+							{Ip: "127.0.0.1", State: "Ready"},
+							{Ip: "127.0.0.2", State: "NotReady"},
+							{Ip: "127.0.0.3", State: "Ready"},
+						},
+					},
+				},
+				{
+					Type:  payload.Info_AgentEvent_UPDATE,
+					Agent: &payload.Info_Agent{Ip: "127.0.0.2", State: "Ready"},
+				},
+				{
+					Type:  payload.Info_AgentEvent_REMOVE,
+					Agent: &payload.Info_Agent{Ip: "127.0.0.3"},
+				},
+				{
+					Type:  payload.Info_AgentEvent_ADD,
+					Agent: &payload.Info_Agent{Ip: "127.0.0.4", State: "Ready"},
+				},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: NewServer(%v)", err)
+	}
+	defer srv.Stop()
+
+	g, err := NewGateway(
+		WithGatewayDiscovererAddr(srv.Addr),
+		WithGatewayAgentPort(8082),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestStartWatchesDiscoverer(%v)", err)
+	}
+	defer g.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errgroup.Init(ctx)
+	g.Start(ctx)
+
+	wants := []string{"127.0.0.1:8082", "127.0.0.2:8082", "127.0.0.4:8082"}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		addrs := g.(*gateway).pool.Addrs()
+		if reflect.DeepEqual(addrs, wants) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("TestStartWatchesDiscoverer: %v, wanted: %v", addrs, wants)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/vdaas/vald/apis/grpc/agent"
 	"github.com/vdaas/vald/internal/config"
+	"google.golang.org/grpc"
 )
 
 type Option func(*srvs)
@@ -44,6 +45,12 @@ func WithGRPC(srv agent.AgentServer) Option {
 	}
 }
 
+func WithGRPCServerOptions(opts ...grpc.ServerOption) Option {
+	return func(s *srvs) {
+		s.grpcOpts = append(s.grpcOpts, opts...)
+	}
+}
+
 func WithREST(h http.Handler) Option {
 	return func(s *srvs) {
 		s.rest = h
@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2019 kpango (Yusuke Kato)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package service manages the main logic of server.
+package service
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/placement"
+	"github.com/vdaas/vald/internal/timeutil"
+	"google.golang.org/grpc"
+)
+
+type GatewayOption func(*gateway)
+
+var (
+	defaultGatewayOpts = []GatewayOption{
+		WithGatewayDiscoveryDuration("1s"),
+		WithGatewayAgentPort(8082),
+		WithGatewayDialOptions(grpc.WithInsecure()),
+	}
+)
+
+func WithGatewayDiscovererAddr(addr string) GatewayOption {
+	return func(g *gateway) {
+		g.discovererAddr = addr
+	}
+}
+
+func WithGatewayMetaAddr(addr string) GatewayOption {
+	return func(g *gateway) {
+		g.metaAddr = addr
+	}
+}
+
+func WithGatewayDiscoveryDuration(dur string) GatewayOption {
+	return func(g *gateway) {
+		if dur == "" {
+			return
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			d = time.Second
+		}
+		g.discoveryDuration = d
+	}
+}
+
+func WithGatewayAgentPort(port int) GatewayOption {
+	return func(g *gateway) {
+		if port <= 0 {
+			return
+		}
+		g.agentPort = port
+	}
+}
+
+func WithGatewayDialOptions(opts ...grpc.DialOption) GatewayOption {
+	return func(g *gateway) {
+		g.dialOpts = opts
+	}
+}
+
+func WithGatewayVirtualNodes(n int) GatewayOption {
+	return func(g *gateway) {
+		g.placementOpts = append(g.placementOpts, placement.WithVirtualNodes(n))
+	}
+}
+
+func WithGatewayReplicas(n int) GatewayOption {
+	return func(g *gateway) {
+		g.placementOpts = append(g.placementOpts, placement.WithReplicas(n))
+	}
+}
@@ -40,10 +40,11 @@ import (
 type Server servers.Listener
 
 type srvs struct {
-	rest http.Handler
-	gql  http.Handler
-	grpc agent.AgentServer
-	cfg  *config.Servers
+	rest     http.Handler
+	gql      http.Handler
+	grpc     agent.AgentServer
+	grpcOpts []grpc.ServerOption
+	cfg      *config.Servers
 }
 
 func NewServer(sopts ...Option) (Server, error) {
@@ -118,7 +119,8 @@ func (s *srvs) setupAPIs(cfg *tls.Config) ([]servers.Option, error) {
 			}
 			opts = append(opts, servers.WithServer(srv))
 		case server.GRPC:
-			gopts := make([]grpc.ServerOption, 0, len(sc.GRPC.Interceptors))
+			gopts := make([]grpc.ServerOption, 0, len(sc.GRPC.Interceptors)+len(s.grpcOpts))
+			gopts = append(gopts, s.grpcOpts...)
 			for _, ic := range sc.GRPC.Interceptors {
 				switch strings.ToLower(ic) {
 				case "valid", "validate", "validation":
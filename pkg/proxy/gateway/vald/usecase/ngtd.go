@@ -3,59 +3,141 @@ package usecase
 import (
 	"context"
 
+	iconfig "github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errgroup"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/runner"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/traffic"
 	"github.com/vdaas/vald/pkg/proxy/gateway/vald/config"
 	"github.com/vdaas/vald/pkg/proxy/gateway/vald/handler/grpc"
 	"github.com/vdaas/vald/pkg/proxy/gateway/vald/handler/rest"
 	"github.com/vdaas/vald/pkg/proxy/gateway/vald/router"
 	"github.com/vdaas/vald/pkg/proxy/gateway/vald/service"
+	ggrpc "google.golang.org/grpc"
 )
 
 type Runner runner.Runner
 
 type run struct {
-	cfg    *config.Data
-	server service.Server
+	cfg     *config.Data
+	server  service.Server
+	gateway service.Gateway
+	traffic *ggrpc.ClientConn
 }
 
 func New(cfg *config.Data) (Runner, error) {
-	ngt, err := service.NewNGT(cfg.NGT)
+	gw, err := service.NewGateway(
+		service.WithGatewayDiscovererAddr(cfg.Gateway.DiscovererAddr),
+		service.WithGatewayMetaAddr(cfg.Gateway.MetaAddr),
+		service.WithGatewayDiscoveryDuration(cfg.Gateway.DiscoveryDuration),
+		service.WithGatewayAgentPort(cfg.Gateway.AgentPort),
+		service.WithGatewayVirtualNodes(cfg.Gateway.VirtualNodes),
+		service.WithGatewayReplicas(cfg.Gateway.Replicas),
+	)
 	if err != nil {
 		return nil, err
 	}
-	g := grpc.New(grpc.WithNGT(ngt))
+	g := grpc.New(grpc.WithGateway(gw))
 
-	srv, err := service.NewServer(
-		service.WithConfig(cfg.Server),
-		service.WithREST(
-			router.New(
-				router.WithHandler(
-					rest.New(
-						rest.WithAgent(g),
-					),
-				),
+	limiter, conn, err := newLimiter(cfg.Gateway)
+	if err != nil {
+		gw.Close()
+		return nil, err
+	}
+
+	h := router.New(
+		router.WithHandler(
+			rest.New(
+				rest.WithAgent(g),
 			),
 		),
+	)
+	sopts := []service.Option{
+		service.WithConfig(cfg.Server),
 		service.WithGRPC(g),
 		// TODO add GraphQL handler
-	)
+	}
+	if limiter != nil {
+		h = traffic.Handler(limiter, h)
+		sopts = append(sopts, service.WithGRPCServerOptions(
+			ggrpc.UnaryInterceptor(traffic.UnaryServerInterceptor(limiter)),
+			ggrpc.StreamInterceptor(traffic.StreamServerInterceptor(limiter)),
+		))
+	}
 
+	srv, err := service.NewServer(append(sopts, service.WithREST(h))...)
 	if err != nil {
+		gw.Close()
+		if conn != nil {
+			conn.Close()
+		}
 		return nil, err
 	}
 
 	return &run{
-		cfg:    cfg,
-		server: srv,
+		cfg:     cfg,
+		server:  srv,
+		gateway: gw,
+		traffic: conn,
 	}, nil
 }
 
+// newLimiter consults the traffic manager when its address is configured, or enforces the limits of the gateway configuration.
+// The requests are not limited when neither is configured.
+func newLimiter(cfg *iconfig.Gateway) (traffic.Limiter, *ggrpc.ClientConn, error) {
+	if cfg.TrafficAddr != "" {
+		conn, err := ggrpc.Dial(cfg.TrafficAddr, ggrpc.WithInsecure())
+		if err != nil {
+			return nil, nil, errors.ErrGRPCDialFailed(err, cfg.TrafficAddr)
+		}
+		return traffic.NewClient(conn), conn, nil
+	}
+	if cfg.Traffic == nil || len(cfg.Traffic.Tenants) == 0 {
+		return nil, nil, nil
+	}
+	opts, err := cfg.Traffic.Opts()
+	if err != nil {
+		return nil, nil, err
+	}
+	return traffic.New(opts...), nil, nil
+}
+
 func (r *run) PreStart() error {
 	return nil
 }
 
 func (r *run) Start(ctx context.Context) <-chan error {
-	return r.server.ListenAndServe(ctx)
+	ech := make(chan error)
+	sech := r.server.ListenAndServe(ctx)
+	gech := r.gateway.Start(ctx)
+	errgroup.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		var ok bool
+		for sech != nil || gech != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err, ok = <-sech:
+				if !ok {
+					sech = nil
+				}
+			case err, ok = <-gech:
+				if !ok {
+					gech = nil
+				}
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return nil
+				case ech <- err:
+				}
+			}
+		}
+		return nil
+	}))
+	return ech
 }
 
 func (r *run) PreStop() error {
@@ -63,5 +145,14 @@ func (r *run) PreStop() error {
 }
 
 func (r *run) Stop(ctx context.Context) error {
-	return r.server.Shutdown(ctx)
+	err := r.server.Shutdown(ctx)
+	if cerr := r.gateway.Close(); err == nil {
+		err = cerr
+	}
+	if r.traffic != nil {
+		if cerr := r.traffic.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
 }